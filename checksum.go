@@ -0,0 +1,48 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"pokegen/internal/save"
+)
+
+// checksumReport is the response of /checksum: whether every checksum checked is valid, and each result.
+type checksumReport struct {
+	Valid     bool                  `json:"valid"`
+	Checksums []save.ChecksumResult `json:"checksums"`
+}
+
+// validateChecksums verifies the checksums of the save uploaded as the "save" multipart form file.
+// With ?repair=true the save is returned with its checksums recomputed instead of the report.
+func validateChecksums(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	f, err := loadUploadedSave(req, "save")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if req.URL.Query().Get("repair") == "true" {
+		f.RepairChecksums()
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		if _, err = w.Write(f.Bytes()); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	resp := checksumReport{Valid: true, Checksums: f.Checksums()}
+	for _, c := range resp.Checksums {
+		resp.Valid = resp.Valid && c.Valid()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		panic(err)
+	}
+}
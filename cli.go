@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+	"io"
+)
+
+// runCommand runs the CLI subcommand named by args[0].
+// Without any arguments pokegen serves the HTTP API instead.
+func runCommand(args []string, stdout io.Writer) error {
+	switch args[0] {
+	case "gen":
+		return gen(args[1:], stdout)
+	case "inspect":
+		return inspect(args[1:], stdout)
+	case "diff":
+		return diff(args[1:], stdout)
+	case "export":
+		return export(args[1:], stdout)
+	case "pk1":
+		return pk1(args[1:], stdout)
+	case "merge":
+		return merge(args[1:], stdout)
+	case "edit":
+		return edit(args[1:], stdout)
+	}
+
+	return fmt.Errorf("unknown command %q, available commands: gen, inspect, diff, export, pk1, merge, edit", args[0])
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"pokegen/internal/save"
+)
+
+// diagnoseFile reports structural problems in the save uploaded as the "save" multipart form file.
+func diagnoseFile(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	f, err := loadUploadedSave(req, "save")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	problems := save.Diagnose(f)
+	if problems == nil {
+		problems = []save.Problem{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(problems); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"pokegen/internal/save"
+)
+
+func diff(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("diff", flag.ContinueOnError)
+	asJSON := flags.Bool("json", false, "print the differences as JSON")
+	language := flags.String("language", "en", "language of the save, such as en or ja")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 2 {
+		return fmt.Errorf("usage: pokegen diff [--json] [--language <code>] <before.sav> <after.sav>")
+	}
+
+	before, err := loadSaveFile(flags.Arg(0), *language)
+	if err != nil {
+		return err
+	}
+
+	after, err := loadSaveFile(flags.Arg(1), *language)
+	if err != nil {
+		return err
+	}
+
+	differences, err := save.Diff(before, after)
+	if err != nil {
+		return fmt.Errorf("failed to diff saves: %w", err)
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(differences)
+	}
+
+	for _, d := range differences {
+		if _, err := fmt.Fprintln(stdout, d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diffFiles compares the saves uploaded as the "before" and "after" multipart form files.
+func diffFiles(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	before, err := loadUploadedSave(req, "before")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	after, err := loadUploadedSave(req, "after")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	differences, err := save.Diff(before, after)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	if differences == nil {
+		differences = []save.Difference{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(differences); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"pokegen/internal/save"
+)
+
+func edit(args []string, stdout io.Writer) error {
+	const usage = "usage: pokegen edit [--language <code>] <file.sav> <operations.json>\n" +
+		"operations are heal, max_pp, set_level, evolve, sort, move and release, such as [{\"op\": \"set_level\", \"level\": 50}]"
+
+	flags := flag.NewFlagSet("edit", flag.ContinueOnError)
+	language := flags.String("language", "en", "language of the save, such as en or ja")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 2 {
+		return fmt.Errorf(usage)
+	}
+
+	f, err := loadSaveFile(flags.Arg(0), *language)
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(flags.Arg(1))
+	if err != nil {
+		return fmt.Errorf("failed to read operations: %w", err)
+	}
+	var ops []save.Operation
+	if err := json.Unmarshal(data, &ops); err != nil {
+		return fmt.Errorf("failed to parse operations: %w", err)
+	}
+
+	if err := f.Edit(ops); err != nil {
+		return fmt.Errorf("failed to edit: %w", err)
+	}
+	f.RepairChecksums()
+
+	_, err = stdout.Write(f.Bytes())
+	return err
+}
+
+// editSave applies the JSON list of operations in the "operations" multipart form field to the save uploaded as "save",
+// returning it with its checksums recomputed.
+func editSave(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	f, err := loadUploadedSave(req, "save")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var ops []save.Operation
+	if err := json.Unmarshal([]byte(req.FormValue("operations")), &ops); err != nil {
+		http.Error(w, fmt.Sprintf("operations: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	if err := f.Edit(ops); err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	f.RepairChecksums()
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if _, err = w.Write(f.Bytes()); err != nil {
+		panic(err)
+	}
+}
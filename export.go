@@ -0,0 +1,61 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"pokegen/internal/showdown"
+)
+
+func export(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	boxes := flags.Bool("boxes", false, "export each box as well as the party")
+	language := flags.String("language", "en", "language of the save, such as en or ja")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		return fmt.Errorf("usage: pokegen export [--boxes] [--language <code>] <file.sav>")
+	}
+
+	f, err := loadSaveFile(flags.Arg(0), *language)
+	if err != nil {
+		return err
+	}
+
+	text, err := showdown.Export(f, *boxes)
+	if err != nil {
+		return fmt.Errorf("failed to export save: %w", err)
+	}
+
+	_, err = io.WriteString(stdout, text)
+	return err
+}
+
+// exportShowdown returns the party of the save uploaded as the "save" multipart form file as Showdown team text.
+// With ?boxes=true each box holding Pokémon is exported too.
+func exportShowdown(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	f, err := loadUploadedSave(req, "save")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	text, err := showdown.Export(f, req.URL.Query().Get("boxes") == "true")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if _, err = io.WriteString(w, text); err != nil {
+		panic(err)
+	}
+}
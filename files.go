@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"pokegen/internal/save"
+)
+
+// loadSaveFile reads and loads the save at path, using the layout of the given language.
+func loadSaveFile(path, language string) (*save.File, error) {
+	layout, ok := save.Layouts[language]
+	if !ok {
+		return nil, fmt.Errorf("unknown language %q", language)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read save: %w", err)
+	}
+
+	f, err := save.LoadLayout(data, layout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load %s: %w", path, err)
+	}
+
+	return f, nil
+}
+
+// loadUploadedSave loads the save uploaded in the multipart form field with the given name,
+// using the layout of the language given by the language query parameter or form field, or English without one.
+func loadUploadedSave(req *http.Request, field string) (*save.File, error) {
+	data, err := readUploadedFile(req, field, save.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	language := req.FormValue("language")
+	if language == "" {
+		language = "en"
+	}
+	layout, ok := save.Layouts[language]
+	if !ok {
+		return nil, fmt.Errorf("unknown language %q", language)
+	}
+
+	f, err := save.LoadLayout(data, layout)
+	if err != nil {
+		return nil, fmt.Errorf("save file %q: %w", field, err)
+	}
+
+	return f, nil
+}
+
+// readUploadedFile reads the file uploaded in the multipart form field with the given name.
+// Reading stops one byte past limit, so oversized files are read no further than needed to reject them.
+func readUploadedFile(req *http.Request, field string, limit int64) ([]byte, error) {
+	const maxMemory = 1 << 20
+	if err := req.ParseMultipartForm(maxMemory); err != nil {
+		return nil, fmt.Errorf("failed to parse multipart form: %w", err)
+	}
+
+	file, _, err := req.FormFile(field)
+	if err != nil {
+		return nil, fmt.Errorf("missing file %q: %w", field, err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %q: %w", field, err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"pokegen/internal/gifts"
+	"pokegen/internal/util"
+)
+
+// listGifts lists the in-game trade and event Pokémon that can be placed in generated saves.
+func listGifts(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// OT names are listed as the games show them, rather than as the control character the save stores.
+	list := gifts.All()
+	for i := range list {
+		list[i].OTName = util.DisplayText(list[i].OTName)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(list); err != nil {
+		panic(err)
+	}
+}
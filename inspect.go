@@ -0,0 +1,143 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"pokegen/internal/save"
+	"strings"
+)
+
+func inspect(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("inspect", flag.ContinueOnError)
+	asJSON := flags.Bool("json", false, "print the summary as JSON")
+	language := flags.String("language", "en", "language of the save, such as en or ja")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		return fmt.Errorf("usage: pokegen inspect [--json] [--language <code>] <file.sav>")
+	}
+
+	f, err := loadSaveFile(flags.Arg(0), *language)
+	if err != nil {
+		return err
+	}
+
+	summary, err := f.Summary()
+	if err != nil {
+		return fmt.Errorf("failed to read save: %w", err)
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(summary)
+	}
+
+	_, err = io.WriteString(stdout, formatSummary(summary))
+	return err
+}
+
+func formatSummary(s save.Summary) string {
+	var b strings.Builder
+
+	badges := "none"
+	if len(s.Badges) > 0 {
+		badges = strings.Join(s.Badges, ", ")
+	}
+
+	fmt.Fprintf(&b, "Player:    %s (ID %05d)\n", s.PlayerName, s.PlayerID)
+	fmt.Fprintf(&b, "Rival:     %s\n", s.RivalName)
+	fmt.Fprintf(&b, "Money:     ¥%d\n", s.Money)
+	fmt.Fprintf(&b, "Coins:     %d\n", s.Coins)
+	fmt.Fprintf(&b, "Badges:    %s\n", badges)
+	fmt.Fprintf(&b, "Play time: %d:%02d:%02d\n", s.PlayTime.Hours, s.PlayTime.Minutes, s.PlayTime.Seconds)
+	fmt.Fprintf(&b, "Location:  %s (x %d, y %d)\n", s.Location.Name, s.Location.X, s.Location.Y)
+	fmt.Fprintf(&b, "Pokédex:   %d owned, %d seen\n", s.Pokedex.Owned, s.Pokedex.Seen)
+	if s.PikachuFriendship > 0 {
+		fmt.Fprintf(&b, "Pikachu:   friendship %d\n", s.PikachuFriendship)
+	}
+
+	fmt.Fprintf(&b, "\nParty (%d/6)\n", len(s.Party))
+	formatPokemonList(&b, s.Party)
+
+	fmt.Fprintf(&b, "\nBoxes\n")
+	for _, box := range s.Boxes {
+		current := ""
+		if box.Current {
+			current = " (current)"
+		}
+		fmt.Fprintf(&b, "  Box %d%s: %d Pokémon\n", box.Number, current, len(box.Pokemon))
+		formatPokemonList(&b, box.Pokemon)
+	}
+
+	fmt.Fprintf(&b, "\nBag (%d/20)\n", len(s.Bag))
+	formatItems(&b, s.Bag)
+
+	fmt.Fprintf(&b, "\nPC items (%d/50)\n", len(s.PCItems))
+	formatItems(&b, s.PCItems)
+
+	fmt.Fprintf(&b, "\nChecksums\n")
+	for _, c := range s.Checksums {
+		if c.Skipped != "" {
+			fmt.Fprintf(&b, "  %s (0x%04X): %s\n", c.Name, c.Offset, c.Skipped)
+			continue
+		}
+		if c.Valid() {
+			fmt.Fprintf(&b, "  %s (0x%04X): OK\n", c.Name, c.Offset)
+			continue
+		}
+		fmt.Fprintf(&b, "  %s (0x%04X): MISMATCH stored 0x%02X, expected 0x%02X\n", c.Name, c.Offset, c.Actual, c.Expected)
+	}
+
+	return b.String()
+}
+
+func formatPokemonList(b *strings.Builder, list []save.PokemonSummary) {
+	for i, p := range list {
+		fmt.Fprintf(b, "  %d. %s", i+1, p.Species)
+		if p.Nickname != p.Species {
+			fmt.Fprintf(b, " %q", p.Nickname)
+		}
+		fmt.Fprintf(b, " Lv%d", p.Level)
+		if p.Stats != nil {
+			fmt.Fprintf(b, " HP %d/%d", p.HP, p.Stats.HP)
+		} else {
+			fmt.Fprintf(b, " HP %d", p.HP)
+		}
+		if p.Status != "" {
+			fmt.Fprintf(b, " %s", p.Status)
+		}
+		fmt.Fprintf(b, "\n")
+
+		fmt.Fprintf(b, "     OT %s (%05d), Exp %d\n", p.OTName, p.OTID, p.Exp)
+		if p.Stats != nil {
+			fmt.Fprintf(b, "     Stats    %s\n", formatStats(*p.Stats))
+		}
+		fmt.Fprintf(b, "     DVs      %s\n", formatStats(p.DVs))
+		fmt.Fprintf(b, "     Stat exp %s\n", formatStats(p.StatExp))
+
+		moves := make([]string, 0, len(p.Moves))
+		for _, m := range p.Moves {
+			move := fmt.Sprintf("%s %dPP", m.Name, m.PP)
+			if m.PPUps > 0 {
+				move += fmt.Sprintf(" (%d PP Up)", m.PPUps)
+			}
+			moves = append(moves, move)
+		}
+		fmt.Fprintf(b, "     Moves    %s\n", strings.Join(moves, ", "))
+	}
+}
+
+func formatStats(s save.Stats) string {
+	return fmt.Sprintf("HP %d, Atk %d, Def %d, Spd %d, Spc %d", s.HP, s.Attack, s.Defense, s.Speed, s.Special)
+}
+
+func formatItems(b *strings.Builder, items []save.ItemSummary) {
+	for _, item := range items {
+		fmt.Fprintf(b, "  %s x%d\n", item.Name, item.Quantity)
+	}
+}
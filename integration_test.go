@@ -3,9 +3,12 @@
 package main_test
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"os"
 	"strings"
@@ -200,3 +203,701 @@ func TestIntegration_InvalidBody(t *testing.T) {
 	assert.NoError(err)
 	assert.Equal("syntax error at byte offset 2\n", string(body))
 }
+
+func generateSave(t *testing.T, body string) []byte {
+	req, err := http.NewRequest(
+		http.MethodGet,
+		"http://localhost:8080/gen",
+		strings.NewReader(body),
+	)
+	assert.NoError(t, err)
+
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	data, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	return data
+}
+
+func uploadSaves(t *testing.T, url string, saves map[string][]byte) *http.Response {
+	body := new(bytes.Buffer)
+	mw := multipart.NewWriter(body)
+	for field, data := range saves {
+		fw, err := mw.CreateFormFile(field, field+".sav")
+		assert.NoError(t, err)
+		_, err = fw.Write(data)
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, mw.Close())
+
+	resp, err := http.Post(url, mw.FormDataContentType(), body)
+	assert.NoError(t, err)
+	return resp
+}
+
+func TestIntegration_Diff(t *testing.T) {
+	assert := assert.New(t)
+	assert.Eventually(healthCheckCondition, 5*time.Second, 100*time.Millisecond)
+
+	resp := uploadSaves(t, "http://localhost:8080/diff", map[string][]byte{
+		"before": generateSave(t, `{"money": 3000}`),
+		"after":  generateSave(t, `{"money": 4000}`),
+	})
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	type difference struct {
+		Field string `json:"field"`
+		Old   string `json:"old"`
+		New   string `json:"new"`
+	}
+
+	var differences []difference
+	assert.NoError(json.NewDecoder(resp.Body).Decode(&differences))
+	assert.Contains(differences, difference{Field: "money", Old: "3000", New: "4000"})
+}
+
+func TestIntegration_ChecksumValidation(t *testing.T) {
+	assert := assert.New(t)
+	assert.Eventually(healthCheckCondition, 5*time.Second, 100*time.Millisecond)
+
+	data := generateSave(t, ``)
+	data[checksumStart] = 0x00
+
+	resp := uploadSaves(t, "http://localhost:8080/checksum", map[string][]byte{"save": data})
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	var report struct {
+		Valid     bool `json:"valid"`
+		Checksums []struct {
+			Name     string `json:"name"`
+			Expected byte   `json:"expected"`
+			Actual   byte   `json:"actual"`
+			Skipped  string `json:"skipped"`
+		} `json:"checksums"`
+	}
+	assert.NoError(json.NewDecoder(resp.Body).Decode(&report))
+	assert.False(report.Valid)
+	assert.Equal("main", report.Checksums[0].Name)
+	assert.Equal(byte(0x6D), report.Checksums[0].Expected)
+	assert.Equal(byte(0x00), report.Checksums[0].Actual)
+	assert.Len(report.Checksums, 15, "the box checksums should be reported too")
+	assert.Equal("boxes uninitialised, not checked", report.Checksums[1].Skipped)
+
+	resp = uploadSaves(t, "http://localhost:8080/checksum?repair=true", map[string][]byte{"save": data})
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	repaired, err := io.ReadAll(resp.Body)
+	assert.NoError(err)
+	assert.Len(repaired, 32768)
+	assert.Equal([]byte{0x6D}, repaired[checksumStart:checksumEnd], "checksum is incorrect")
+}
+
+func TestIntegration_Diagnostics(t *testing.T) {
+	assert := assert.New(t)
+	assert.Eventually(healthCheckCondition, 5*time.Second, 100*time.Millisecond)
+
+	data := generateSave(t, ``)
+	partyCountOffset := 0x2F2C
+	data[partyCountOffset] = 7
+
+	resp := uploadSaves(t, "http://localhost:8080/diagnostics", map[string][]byte{"save": data})
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	var problems []struct {
+		Severity string `json:"severity"`
+		Offset   int    `json:"offset"`
+		Field    string `json:"field"`
+	}
+	assert.NoError(json.NewDecoder(resp.Body).Decode(&problems))
+	assert.Len(problems, 2)
+	assert.Equal("main checksum", problems[0].Field)
+	assert.Equal("party", problems[1].Field)
+	assert.Equal("error", problems[1].Severity)
+	assert.Equal(partyCountOffset, problems[1].Offset)
+}
+
+func TestIntegration_YellowPikachuFriendship(t *testing.T) {
+	assert := assert.New(t)
+	assert.Eventually(healthCheckCondition, 5*time.Second, 100*time.Millisecond)
+
+	pikachuFriendshipOffset := 0x271C
+	pikachuFollowingOffset := 0x271E
+	partyOffset := 0x2F2C
+
+	body := generateSave(t, `{"game": "yellow", "starter": "pikachu"}`)
+	assert.Equal(byte(90), body[pikachuFriendshipOffset], "default friendship is incorrect")
+	assert.Equal(byte(0x80), body[pikachuFollowingOffset]&0x80, "pikachu should follow the player")
+	assert.Equal([]byte{1, 0x54}, body[partyOffset:partyOffset+2], "pikachu should be in the party")
+
+	body = generateSave(t, `{"game": "yellow", "starter": "pikachu", "pikachu_friendship": 255}`)
+	assert.Equal(byte(255), body[pikachuFriendshipOffset], "friendship is incorrect")
+}
+
+func TestIntegration_JapaneseSave(t *testing.T) {
+	assert := assert.New(t)
+	assert.Eventually(healthCheckCondition, 5*time.Second, 100*time.Millisecond)
+
+	playerNameOffset := 0x2598
+	rivalNameOffset := 0x25F1
+	checksumOffset := 0x3594
+
+	body := generateSave(t, `{"language": "ja", "player_name": "サトシ"}`)
+	assert.Equal([]byte{0x8A, 0x93, 0x8B, 0x50, 0x00, 0x00}, body[playerNameOffset:playerNameOffset+6], "player name is incorrect")
+	assert.Equal([]byte{0x07, 0xD8, 0xE3, 0xAB, 0x50, 0x00}, body[rivalNameOffset:rivalNameOffset+6], "rival name is incorrect")
+
+	var sum byte
+	for _, b := range body[playerNameOffset:checksumOffset] {
+		sum += b
+	}
+	assert.Equal(^sum, body[checksumOffset], "checksum is incorrect")
+}
+
+func TestIntegration_EuropeanSave(t *testing.T) {
+	assert := assert.New(t)
+	assert.Eventually(healthCheckCondition, 5*time.Second, 100*time.Millisecond)
+
+	playerNameOffset := 0x2598
+	rivalNameOffset := 0x25F6
+
+	body := generateSave(t, `{"language": "de"}`)
+	assert.Equal([]byte{0x91, 0x8E, 0x93, 0x50}, body[playerNameOffset:playerNameOffset+4], "default player name is incorrect")
+	assert.Equal([]byte{0x81, 0x8B, 0x80, 0x94, 0x50}, body[rivalNameOffset:rivalNameOffset+5], "default rival name is incorrect")
+
+	body = generateSave(t, `{"language": "fr", "player_name": "Françoise"}`)
+	assert.Equal([]byte{0x85, 0xB1, 0xA0, 0xAD, 0xBF, 0xAE, 0xA8, 0xB2, 0xA4, 0x50}, body[playerNameOffset:playerNameOffset+10], "player name is incorrect")
+}
+
+func TestIntegration_ShowdownParty(t *testing.T) {
+	assert := assert.New(t)
+	assert.Eventually(healthCheckCondition, 5*time.Second, 100*time.Millisecond)
+
+	partyOffset := 0x2F2C
+	firstPokemonOffset := 0x2F34
+	firstNicknameOffset := 0x307E
+	checksumOffset := 0x3523
+
+	body := generateSave(t, `{"party": {"showdown": "Tauros\n- Body Slam\n- Hyper Beam"}}`)
+	assert.Equal([]byte{0x01, 0x3C, 0xFF}, body[partyOffset:partyOffset+3], "party species list is incorrect")
+	assert.Equal([]byte{0x01, 0x61}, body[firstPokemonOffset+34:firstPokemonOffset+36], "max HP is incorrect")
+	assert.Equal([]byte{0x01, 0x3E}, body[firstPokemonOffset+40:firstPokemonOffset+42], "speed is incorrect")
+	assert.Equal([]byte{0x93, 0x80, 0x94, 0x91, 0x8E, 0x92, 0x50}, body[firstNicknameOffset:firstNicknameOffset+7], "nickname is incorrect")
+
+	var sum byte
+	for _, b := range body[0x2598:checksumOffset] {
+		sum += b
+	}
+	assert.Equal(^sum, body[checksumOffset], "checksum is incorrect")
+
+	// Without moves, Tauros knows the last four moves it learns by level 100.
+	body = generateSave(t, `{"party": {"showdown": "Tauros"}}`)
+	assert.Equal([]byte{0x27, 0x2B, 0x63, 0x24}, body[firstPokemonOffset+8:firstPokemonOffset+12], "default moves are incorrect")
+}
+
+func TestIntegration_ShowdownExport(t *testing.T) {
+	assert := assert.New(t)
+	assert.Eventually(healthCheckCondition, 5*time.Second, 100*time.Millisecond)
+
+	data := generateSave(t, `{"party": {"showdown": "Rex (Tauros)\nLevel: 50\n- Body Slam\n- Hyper Beam"}}`)
+
+	resp := uploadSaves(t, "http://localhost:8080/showdown", map[string][]byte{"save": data})
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	text, err := io.ReadAll(resp.Body)
+	assert.NoError(err)
+	assert.Equal("=== [gen1] Party ===\n\nRex (Tauros)\nLevel: 50\n- Body Slam\n- Hyper Beam\n\n", string(text))
+}
+
+func TestIntegration_PK1(t *testing.T) {
+	assert := assert.New(t)
+	assert.Eventually(healthCheckCondition, 5*time.Second, 100*time.Millisecond)
+
+	partyOffset := 0x2F2C
+
+	withTauros := generateSave(t, `{"party": {"showdown": "Tauros\n- Body Slam"}}`)
+	resp := uploadSaves(t, "http://localhost:8080/pk1/export?slot=party:1", map[string][]byte{"save": withTauros})
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	pk1, err := io.ReadAll(resp.Body)
+	assert.NoError(err)
+	assert.Len(pk1, 69)
+
+	empty := generateSave(t, ``)
+	resp = uploadSaves(t, "http://localhost:8080/pk1/import?slot=party:1", map[string][]byte{"save": empty, "pk1": pk1})
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	imported, err := io.ReadAll(resp.Body)
+	assert.NoError(err)
+	assert.Equal(withTauros[partyOffset:partyOffset+404], imported[partyOffset:partyOffset+404], "party is incorrect")
+
+	resp = uploadSaves(t, "http://localhost:8080/pk1/export?slot=party:2", map[string][]byte{"save": withTauros})
+	assert.Equal(http.StatusUnprocessableEntity, resp.StatusCode)
+}
+
+func TestIntegration_Trade(t *testing.T) {
+	assert := assert.New(t)
+	assert.Eventually(healthCheckCondition, 5*time.Second, 100*time.Millisecond)
+
+	partyOffset := 0x2F2C
+
+	saves := map[string][]byte{
+		"a": generateSave(t, `{"party": {"showdown": "Kadabra\nLevel: 30\n- Confusion"}}`),
+		"b": generateSave(t, `{"player_name": "GARY", "party": {"showdown": "Tauros\nLevel: 30\n- Tackle"}}`),
+	}
+	resp := uploadSaves(t, "http://localhost:8080/trade?a=party:1&b=party:1", saves)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	type side struct {
+		Received struct {
+			Species string `json:"species"`
+			Evolved string `json:"evolved"`
+		} `json:"received"`
+		Save []byte `json:"save"`
+	}
+	var traded struct {
+		A side `json:"a"`
+		B side `json:"b"`
+	}
+	assert.NoError(json.NewDecoder(resp.Body).Decode(&traded))
+	assert.Equal("TAUROS", traded.A.Received.Species)
+	assert.Empty(traded.A.Received.Evolved)
+	assert.Equal("KADABRA", traded.B.Received.Species)
+	assert.Equal("ALAKAZAM", traded.B.Received.Evolved)
+	assert.Equal(byte(0x3C), traded.A.Save[partyOffset+1], "a should hold the tauros")
+	assert.Equal(byte(0x95), traded.B.Save[partyOffset+1], "b should hold the evolved alakazam")
+
+	resp = uploadSaves(t, "http://localhost:8080/trade?a=party:1&b=party:1&evolve=false", saves)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	assert.NoError(json.NewDecoder(resp.Body).Decode(&traded))
+	assert.Equal(byte(0x26), traded.B.Save[partyOffset+1], "kadabra should not evolve")
+
+	resp = uploadSaves(t, "http://localhost:8080/trade?a=party:2&b=party:1", saves)
+	assert.Equal(http.StatusUnprocessableEntity, resp.StatusCode)
+
+	japanesePartyOffset := 0x2ED5
+	japanese := map[string][]byte{
+		"a": generateSave(t, `{"language": "ja", "party": {"showdown": "ユンゲラー (Kadabra)\nLevel: 30\n- Confusion"}}`),
+		"b": generateSave(t, `{"language": "ja", "party": {"showdown": "ケンタロス (Tauros)\nLevel: 30\n- Tackle"}}`),
+	}
+	resp = uploadSaves(t, "http://localhost:8080/trade?a=party:1&b=party:1&language=ja", japanese)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	assert.NoError(json.NewDecoder(resp.Body).Decode(&traded))
+	assert.Equal(byte(0x3C), traded.A.Save[japanesePartyOffset+1], "a should hold the tauros")
+	assert.Equal(byte(0x95), traded.B.Save[japanesePartyOffset+1], "b should hold the evolved alakazam")
+
+	resp = uploadSaves(t, "http://localhost:8080/trade?a=party:1&b=party:1&language=xx", japanese)
+	assert.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestIntegration_Merge(t *testing.T) {
+	assert := assert.New(t)
+	assert.Eventually(healthCheckCondition, 5*time.Second, 100*time.Millisecond)
+
+	badgesOffset := 0x2602
+	// BEAT_KOGA is event flag 0x259.
+	beatKogaOffset, beatKogaBit := 0x29F3+0x259/8, byte(1<<(0x259%8))
+	mapOffset := 0x260A
+	partyOffset := 0x2F2C
+
+	saves := map[string][]byte{
+		"base":     generateSave(t, `{"party": {"showdown": "Tauros\nLevel: 30\n- Tackle"}}`),
+		"midgame":  generateSave(t, `{"badges": 5}`),
+		"pikachus": generateSave(t, `{"party": {"showdown": "Pikachu\nLevel: 25\n- Thundershock"}}`),
+	}
+	resp := uploadSaves(t, "http://localhost:8080/merge?take=progress=midgame&take=party=pikachus", saves)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	assert.Contains(resp.Header.Values("X-Reconciled"), "pokedex: marked PIKACHU as owned")
+
+	merged, err := io.ReadAll(resp.Body)
+	assert.NoError(err)
+	assert.Equal(byte(0x1F), merged[badgesOffset], "badges should be taken from midgame")
+	assert.NotZero(merged[beatKogaOffset]&beatKogaBit, "event flags should be taken from midgame")
+	assert.Equal(byte(0x07), merged[mapOffset], "location should be taken from midgame")
+	assert.Equal(byte(0x54), merged[partyOffset+1], "party should be taken from pikachus")
+
+	for _, query := range []string{"", "?take=badges=midgame", "?take=progress=missing"} {
+		resp = uploadSaves(t, "http://localhost:8080/merge"+query, saves)
+		assert.Equal(http.StatusBadRequest, resp.StatusCode, query)
+	}
+}
+
+func TestIntegration_Edit(t *testing.T) {
+	assert := assert.New(t)
+	assert.Eventually(healthCheckCondition, 5*time.Second, 100*time.Millisecond)
+
+	partyOffset := 0x2F2C
+	firstPokemonOffset := partyOffset + 8
+	levelOffset := 0x21
+
+	edit := func(operations string) *http.Response {
+		body := new(bytes.Buffer)
+		mw := multipart.NewWriter(body)
+		fw, err := mw.CreateFormFile("save", "save.sav")
+		assert.NoError(err)
+		_, err = fw.Write(generateSave(t, `{"party": {"showdown": "Kadabra\nLevel: 20\n- Confusion"}}`))
+		assert.NoError(err)
+		assert.NoError(mw.WriteField("operations", operations))
+		assert.NoError(mw.Close())
+
+		resp, err := http.Post("http://localhost:8080/edit", mw.FormDataContentType(), body)
+		assert.NoError(err)
+		return resp
+	}
+
+	resp := edit(`[{"op": "set_level", "level": 40}, {"op": "evolve", "slot": "party:1"}, {"op": "heal"}]`)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	edited, err := io.ReadAll(resp.Body)
+	assert.NoError(err)
+	assert.Equal(byte(0x95), edited[partyOffset+1], "kadabra should evolve into alakazam")
+	assert.Equal(byte(0x95), edited[firstPokemonOffset])
+	assert.Equal(byte(40), edited[firstPokemonOffset+levelOffset])
+
+	resp = edit(`[{"op": "sort", "by": "level"}]`)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	resp = edit(`[{"op": "evolve", "slot": "party:2"}]`)
+	assert.Equal(http.StatusUnprocessableEntity, resp.StatusCode)
+	resp = edit(`[{"op": "move", "slot": "party:1", "to": "box:1:1"}]`)
+	assert.Equal(http.StatusUnprocessableEntity, resp.StatusCode, "the party cannot be left empty")
+	resp = edit(`{"op": "heal"}`)
+	assert.Equal(http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestIntegration_Legality(t *testing.T) {
+	assert := assert.New(t)
+	assert.Eventually(healthCheckCondition, 5*time.Second, 100*time.Millisecond)
+
+	illegal := `"party": {"showdown": "Charmander\nLevel: 5\n- Scratch\n- Surf"}`
+
+	for mode, want := range map[string]int{
+		"allow":  http.StatusOK,
+		"warn":   http.StatusOK,
+		"reject": http.StatusUnprocessableEntity,
+	} {
+		req, err := http.NewRequest(
+			http.MethodGet,
+			"http://localhost:8080/gen",
+			strings.NewReader(fmt.Sprintf(`{"legality": %q, %s}`, mode, illegal)),
+		)
+		assert.NoError(err)
+
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(err)
+		assert.Equal(want, resp.StatusCode, mode)
+
+		findings := resp.Header.Values("X-Legality-Finding")
+		if mode == "warn" {
+			assert.Equal([]string{"party:1 CHARMANDER: cannot learn SURF by level 5"}, findings)
+		} else {
+			assert.Empty(findings, mode)
+		}
+
+		if mode == "reject" {
+			body, err := io.ReadAll(resp.Body)
+			assert.NoError(err)
+			assert.JSONEq(`[{"slot": "party:1", "species": "CHARMANDER", "severity": "error", "message": "cannot learn SURF by level 5"}]`, string(body))
+		}
+	}
+
+	generateSave(t, `{"legality": "reject", "party": {"showdown": "Charmander\nLevel: 5\n- Scratch\n- Growl"}}`)
+}
+
+func TestIntegration_Starter(t *testing.T) {
+	assert := assert.New(t)
+	assert.Eventually(healthCheckCondition, 5*time.Second, 100*time.Millisecond)
+
+	partyOffset := 0x2F2C
+	rivalStarterOffset := 0x29C1
+	playerStarterOffset := 0x29C3
+
+	body := generateSave(t, `{"starter": "SQUIRTLE"}`)
+	assert.Equal([]byte{0x01, 0xB1, 0xFF}, body[partyOffset:partyOffset+3], "party species list is incorrect")
+	assert.Equal(byte(0x99), body[rivalStarterOffset], "rival starter should be Bulbasaur")
+	assert.Equal(byte(0xB1), body[playerStarterOffset], "player starter should be Squirtle")
+}
+
+func TestIntegration_Reconcile(t *testing.T) {
+	assert := assert.New(t)
+	assert.Eventually(healthCheckCondition, 5*time.Second, 100*time.Millisecond)
+
+	// Tauros is number 128, the last flag of the sixteenth byte
+	taurosOwnedOffset := 0x25A3 + 15
+
+	req, err := http.NewRequest(
+		http.MethodGet,
+		"http://localhost:8080/gen",
+		strings.NewReader(`{"reconcile": true, "party": {"showdown": "Tauros\nLevel: 10"}}`),
+	)
+	assert.NoError(err)
+
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	assert.Equal([]string{"pokedex: marked TAUROS as owned", "pokedex: marked TAUROS as seen"}, resp.Header.Values("X-Reconciled"))
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(err)
+	assert.Equal(byte(0x80), body[taurosOwnedOffset]&0x80, "Tauros should be owned")
+}
+
+func TestIntegration_Scenario(t *testing.T) {
+	assert := assert.New(t)
+	assert.Eventually(healthCheckCondition, 5*time.Second, 100*time.Millisecond)
+
+	playerNameOffset := 0x2598
+	badgesOffset := 0x2602
+	partyOffset := 0x2F2C
+
+	scenario := `
+trainer:
+  name: ASH
+  badges: [Boulder, Cascade]
+party:
+  - species: Pikachu
+    level: 25
+`
+	req, err := http.NewRequest(http.MethodPost, "http://localhost:8080/gen", strings.NewReader(scenario))
+	assert.NoError(err)
+	req.Header.Set("Content-Type", "application/yaml")
+
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(err)
+	assert.Equal([]byte{0x80, 0x92, 0x87, 0x50}, body[playerNameOffset:playerNameOffset+4], "player name should be ASH")
+	assert.Equal(byte(0x03), body[badgesOffset], "badges are incorrect")
+	assert.Equal([]byte{0x01, 0x54, 0xFF}, body[partyOffset:partyOffset+3], "party species list is incorrect")
+
+	req, err = http.NewRequest(http.MethodPost, "http://localhost:8080/gen", strings.NewReader("party:\n  - species: Pikachoo\n    level: 5\n"))
+	assert.NoError(err)
+	req.Header.Set("Content-Type", "application/yaml")
+
+	resp, err = http.DefaultClient.Do(req)
+	assert.NoError(err)
+	assert.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	body, err = io.ReadAll(resp.Body)
+	assert.NoError(err)
+	assert.Equal("scenario:2:14: unknown species \"Pikachoo\"\n", string(body))
+}
+
+func TestIntegration_ScenarioLibrary(t *testing.T) {
+	assert := assert.New(t)
+	assert.Eventually(healthCheckCondition, 5*time.Second, 100*time.Millisecond)
+
+	playerNameOffset := 0x2598
+	badgesOffset := 0x2602
+
+	resp, err := http.Get("http://localhost:8080/scenarios")
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	var presets []struct {
+		Name        string   `json:"name"`
+		Description string   `json:"description"`
+		Tags        []string `json:"tags"`
+	}
+	assert.NoError(json.NewDecoder(resp.Body).Decode(&presets))
+	var names []string
+	for _, p := range presets {
+		names = append(names, p.Name)
+	}
+	assert.Contains(names, "elite-four")
+	assert.Contains(names, "nuzlocke-start")
+
+	resp, err = http.Post(
+		"http://localhost:8080/gen?scenario=elite-four",
+		"application/json",
+		strings.NewReader(`{"trainer": {"name": "ASH"}}`),
+	)
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(err)
+	assert.Equal([]byte{0x80, 0x92, 0x87, 0x50}, body[playerNameOffset:playerNameOffset+4], "player name should be ASH")
+	assert.Equal(byte(0xFF), body[badgesOffset], "all badges should be obtained")
+
+	resp, err = http.Post("http://localhost:8080/gen?scenario=elite-five", "application/json", nil)
+	assert.NoError(err)
+	assert.Equal(http.StatusNotFound, resp.StatusCode)
+}
+
+func TestIntegration_RandomParty(t *testing.T) {
+	assert := assert.New(t)
+	assert.Eventually(healthCheckCondition, 5*time.Second, 100*time.Millisecond)
+
+	partyOffset := 0x2F2C
+
+	body := `{"party": {"random": {"seed": 151, "size": 3, "min_level": 20, "max_level": 30, "types": ["fire"]}}}`
+	first := generateSave(t, body)
+	second := generateSave(t, body)
+	assert.Equal(first, second, "the same seed should give the same save")
+	assert.Equal(byte(3), first[partyOffset], "party should hold 3 pokémon")
+
+	other := generateSave(t, `{"party": {"random": {"seed": 152, "size": 3, "min_level": 20, "max_level": 30, "types": ["fire"]}}}`)
+	assert.NotEqual(first, other, "a different seed should give a different save")
+}
+
+func TestIntegration_Badges(t *testing.T) {
+	assert := assert.New(t)
+	assert.Eventually(healthCheckCondition, 5*time.Second, 100*time.Millisecond)
+
+	badgesOffset := 0x2602
+	mapOffset := 0x260A
+	partyOffset := 0x2F2C
+	firstLevelOffset := partyOffset + 8 + 0x21
+
+	data := generateSave(t, `{"badges": 5, "party": {"showdown": "Tauros\nLevel: 10"}}`)
+	assert.Equal(byte(0x1F), data[badgesOffset], "should have the first five badges")
+	assert.Equal(byte(0x07), data[mapOffset], "should be in Fuchsia City")
+	assert.Equal(byte(1), data[partyOffset], "party should be kept")
+	assert.Equal(byte(10), data[firstLevelOffset], "the party's own level should be kept")
+
+	data = generateSave(t, `{"badges": 0}`)
+	assert.Equal(byte(0), data[badgesOffset])
+	assert.Equal(byte(2), data[partyOffset], "an empty party should be filled with a random team")
+}
+
+func TestIntegration_LivingDex(t *testing.T) {
+	assert := assert.New(t)
+	assert.Eventually(healthCheckCondition, 5*time.Second, 100*time.Millisecond)
+
+	pokedexOwnedOffset := 0x25A3
+	currentBoxOffset := 0x30C0
+	bank2Offset := 0x4000
+	bank3Offset := 0x6000
+	boxSize := 0x462
+
+	data := generateSave(t, `{"living_dex": true}`)
+	assert.Equal(byte(20), data[currentBoxOffset], "current box should be full")
+	assert.Equal(byte(20), data[bank2Offset], "box 1 should be full")
+	assert.Equal(byte(20), data[bank3Offset], "box 7 should be full")
+	assert.Equal(byte(11), data[bank3Offset+boxSize], "box 8 should hold the last 11 species")
+	for i := 0; i < 18; i++ {
+		assert.Equal(byte(0xFF), data[pokedexOwnedOffset+i], "every species should be owned")
+	}
+	assert.Equal(byte(0x7F), data[pokedexOwnedOffset+18], "every species should be owned")
+
+	resp, err := http.Post("http://localhost:8080/gen?scenario=living-dex", "application/json", nil)
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(err)
+	assert.Equal(data[bank3Offset:bank3Offset+2*boxSize], body[bank3Offset:bank3Offset+2*boxSize], "the preset should hold the same boxes")
+}
+
+func TestIntegration_Gifts(t *testing.T) {
+	assert := assert.New(t)
+	assert.Eventually(healthCheckCondition, 5*time.Second, 100*time.Millisecond)
+
+	partyOffset := 0x2F2C
+	inGameTradesOffset := 0x29E3
+
+	resp, err := http.Get("http://localhost:8080/gifts")
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+	var list []struct {
+		Name     string `json:"name"`
+		Nickname string `json:"nickname"`
+		OTName   string `json:"ot_name"`
+	}
+	assert.NoError(json.NewDecoder(resp.Body).Decode(&list))
+	assert.Contains(list, struct {
+		Name     string `json:"name"`
+		Nickname string `json:"nickname"`
+		OTName   string `json:"ot_name"`
+	}{"lola", "LOLA", "TRAINER"})
+
+	data := generateSave(t, `{"gifts": [{"name": "lola", "level": 30}, {"name": "yoshira-mew", "box": 1}]}`)
+	assert.Equal(byte(1), data[partyOffset], "lola should join the party")
+	assert.Equal(byte(0x48), data[partyOffset+1], "lola should be a jynx")
+	assert.Equal(byte(1<<6), data[inGameTradesOffset], "the trade for lola should be done")
+
+	resp, err = http.Post("http://localhost:8080/gen", "application/json", strings.NewReader(`{"game": "yellow", "gifts": [{"name": "lola"}]}`))
+	assert.NoError(err)
+	assert.Equal(http.StatusBadRequest, resp.StatusCode, "lola is not traded in yellow")
+}
+
+func TestIntegration_OpenAPI(t *testing.T) {
+	assert := assert.New(t)
+	assert.Eventually(healthCheckCondition, 5*time.Second, 100*time.Millisecond)
+
+	resp, err := http.Get("http://localhost:8080/openapi.json")
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, resp.StatusCode)
+
+	var doc struct {
+		OpenAPI    string `json:"openapi"`
+		Paths      map[string]json.RawMessage
+		Components struct {
+			Schemas map[string]struct {
+				Properties           map[string]map[string]interface{} `json:"properties"`
+				AdditionalProperties bool                              `json:"additionalProperties"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	assert.NoError(json.NewDecoder(resp.Body).Decode(&doc))
+	assert.Equal("3.0.3", doc.OpenAPI)
+	for _, path := range []string{"/gen", "/scenarios", "/gifts", "/diff", "/checksum", "/diagnostics", "/showdown", "/pk1/export", "/pk1/import", "/trade", "/edit", "/merge"} {
+		assert.Contains(doc.Paths, path, "every endpoint should be described")
+	}
+
+	request := doc.Components.Schemas["GenRequest"]
+	assert.False(request.AdditionalProperties, "unknown fields should be rejected")
+	assert.Equal("integer", request.Properties["money"]["type"])
+	assert.Equal(3000.0, request.Properties["money"]["default"])
+	assert.Equal([]interface{}{"red", "blue", "yellow"}, request.Properties["game"]["enum"])
+	assert.Equal(8.0, request.Properties["badges"]["maximum"])
+	assert.Equal(true, request.Properties["badges"]["nullable"], "pointer fields may be null")
+	assert.Equal(true, request.Properties["party"]["nullable"])
+	assert.Contains(doc.Components.Schemas, "Constraints", "party.random should be described")
+	assert.Equal("byte", doc.Components.Schemas["TradedSave"].Properties["save"]["format"], "saves in JSON are base64")
+
+	// Requests are held to the schema.
+	resp, err = http.Post("http://localhost:8080/gen", "application/json", strings.NewReader(`{"badges": null, "language": "xx"}`))
+	assert.NoError(err)
+	assert.Equal(http.StatusBadRequest, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(err)
+	assert.Equal("language must be one of en, fr, de, it, es, ja, got \"xx\"\n", string(body))
+}
+
+func TestIntegration_InvalidGameOptions(t *testing.T) {
+	assert := assert.New(t)
+	assert.Eventually(healthCheckCondition, 5*time.Second, 100*time.Millisecond)
+
+	for _, body := range []string{
+		`{"game": "green"}`,
+		`{"game": "red", "pikachu_friendship": 100}`,
+		`{"game": "yellow", "pikachu_friendship": 100}`,
+		`{"game": "red", "starter": "PIKACHU"}`,
+		`{"language": "xx"}`,
+		`{"language": "ja", "player_name": "ASH"}`,
+		`{"language": "en", "player_name": "Jürgen"}`,
+		`{"player_name": "ASHKETCHUM12"}`,
+		`{"party": {"showdown": "Togepi\n- Metronome"}}`,
+		`{"legality": "maybe"}`,
+		`{"moneys": 9999}`,
+		`{"party": {"random": {"sizes": 3}}}`,
+		`{"party": {"random": {"size": 7}}}`,
+		`{"party": {"showdown": "Tauros", "random": {}}}`,
+		`{"starter": "PIKACHU"}`,
+		`{"game": "yellow", "starter": "BULBASAUR"}`,
+		`{"badges": 9}`,
+		`{"badges": -1}`,
+		`{"language": ""}`,
+	} {
+		req, err := http.NewRequest(
+			http.MethodGet,
+			"http://localhost:8080/gen",
+			strings.NewReader(body),
+		)
+		assert.NoError(err)
+
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(err)
+		assert.Equal(http.StatusBadRequest, resp.StatusCode, body)
+	}
+}
@@ -0,0 +1,68 @@
+package gamedata
+
+// Version is a Gen 1 release.
+type Version string
+
+const (
+	VersionRed    Version = "red"
+	VersionBlue   Version = "blue"
+	VersionYellow Version = "yellow"
+)
+
+// unobtainable lists, by Pokédex number, the species a player cannot catch, receive or evolve in each version.
+// They can only be traded in, so never carry the player's own OT.
+// Species given in in-game trades are included: those carry the trade's OT rather than the player's.
+var unobtainable = map[Version][]int{
+	// Sandshrew, Vulpix, Meowth, Bellsprout, Magmar and Pinsir lines, and Farfetch'd, Mr. Mime, Jynx and Lickitung.
+	VersionRed: {27, 28, 37, 38, 52, 53, 69, 70, 71, 126, 127, 83, 122, 124, 108},
+	// Ekans, Oddish, Mankey and Growlithe lines, Scyther and Electabuzz, and Farfetch'd, Mr. Mime, Jynx and Lickitung.
+	VersionBlue: {23, 24, 43, 44, 45, 56, 57, 58, 59, 123, 125, 83, 122, 124, 108},
+	// Weedle, Ekans, Meowth and Koffing lines, Raichu, Jynx, Electabuzz and Magmar, and Mr. Mime.
+	VersionYellow: {13, 14, 15, 23, 24, 26, 52, 53, 109, 110, 124, 125, 126, 122},
+}
+
+// Obtainable reports whether the species with the given Pokédex number can be obtained in the version without trading.
+// Mew is never obtainable in game.
+func Obtainable(dex int, v Version) bool {
+	if dex == 151 {
+		return false
+	}
+	for _, d := range unobtainable[v] {
+		if d == dex {
+			return false
+		}
+	}
+	return true
+}
+
+// wildLevels holds the lowest level species can be found in the wild at, where that is below the level they evolve at,
+// such as Metapod in Viridian Forest or Gyarados from the Super Rod.
+var wildLevels = map[int]int{
+	11:  4,  // Metapod
+	14:  4,  // Kakuna
+	17:  9,  // Pidgeotto
+	61:  15, // Poliwhirl
+	93:  20, // Haunter
+	99:  15, // Kingler
+	117: 15, // Seadra
+	119: 15, // Seaking
+	130: 15, // Gyarados
+	148: 15, // Dragonair
+}
+
+// MinimumLevel returns the lowest level the species with the given Pokédex number can be obtained at:
+// the highest level any evolution leading to it needs, or the lowest level it is found in the wild if that is lower.
+func MinimumLevel(dex int) int {
+	if level, ok := wildLevels[dex]; ok {
+		return level
+	}
+	e, ok := EvolvesFrom(dex)
+	if !ok {
+		return 1
+	}
+	level := MinimumLevel(e.From)
+	if e.Method == EvolveLevel && e.Level > level {
+		level = e.Level
+	}
+	return level
+}
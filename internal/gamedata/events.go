@@ -0,0 +1,57 @@
+package gamedata
+
+// EventFlagCount is the number of event flags stored in a save.
+const EventFlagCount = 0x140 * 8
+
+// eventFlags names the event flags that pokegen knows about, keyed by their index in the event flag bit field.
+var eventFlags = map[int]string{
+	0x000: "FOLLOWED_OAK_INTO_LAB",
+	0x003: "HALL_OF_FAME_DEX_RATING",
+	0x006: "PALLET_AFTER_GETTING_POKEBALLS",
+	0x018: "GOT_TOWN_MAP",
+	0x019: "ENTERED_BLUES_HOUSE",
+	0x01A: "DAISY_WALKING",
+	0x020: "FOLLOWED_OAK_INTO_LAB_2",
+	0x021: "OAK_ASKED_TO_CHOOSE_MON",
+	0x022: "GOT_STARTER",
+	0x023: "BATTLED_RIVAL_IN_OAKS_LAB",
+	0x024: "GOT_POKEBALLS_FROM_OAK",
+	0x025: "GOT_POKEDEX",
+	0x026: "PALLET_AFTER_GETTING_POKEBALLS_2",
+	0x027: "OAK_APPEARED_IN_PALLET",
+	0x038: "OAK_GOT_PARCEL",
+	0x039: "GOT_OAKS_PARCEL",
+	0x050: "GOT_TM27",
+	0x051: "BEAT_VIRIDIAN_GYM_GIOVANNI",
+	0x076: "GOT_TM34",
+	0x077: "BEAT_BROCK",
+	0x0BE: "GOT_TM11",
+	0x0BF: "BEAT_MISTY",
+	0x0C0: "GOT_BICYCLE",
+	0x166: "GOT_TM24",
+	0x167: "BEAT_LT_SURGE",
+	0x1A8: "GOT_TM21",
+	0x1A9: "BEAT_ERIKA",
+	0x258: "GOT_TM06",
+	0x259: "BEAT_KOGA",
+	0x298: "GOT_TM38",
+	0x299: "BEAT_BLAINE",
+	0x360: "GOT_TM46",
+	0x361: "BEAT_SABRINA",
+}
+
+// EventFlagName returns the name of event flag n.
+func EventFlagName(n int) (string, bool) {
+	name, ok := eventFlags[n]
+	return name, ok
+}
+
+// EventFlagByName returns the index of the event flag with the given name.
+func EventFlagByName(name string) (int, bool) {
+	for n, flagName := range eventFlags {
+		if flagName == name {
+			return n, true
+		}
+	}
+	return 0, false
+}
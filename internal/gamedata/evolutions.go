@@ -0,0 +1,140 @@
+package gamedata
+
+// EvolutionMethod is how a species evolves.
+type EvolutionMethod int
+
+const (
+	EvolveLevel EvolutionMethod = iota
+	EvolveItem
+	EvolveTrade
+)
+
+// Evolution is one step of an evolution family, from one Pokédex number into another.
+// Level is set for level evolutions and Item, an item ID, for evolution stones.
+type Evolution struct {
+	From   int
+	Into   int
+	Method EvolutionMethod
+	Level  int
+	Item   byte
+}
+
+// Evolution stone item IDs.
+const (
+	MoonStone    byte = 0x0A
+	FireStone    byte = 0x20
+	ThunderStone byte = 0x21
+	WaterStone   byte = 0x22
+	LeafStone    byte = 0x2F
+)
+
+var evolutions = []Evolution{
+	{From: 1, Into: 2, Method: EvolveLevel, Level: 16},
+	{From: 2, Into: 3, Method: EvolveLevel, Level: 32},
+	{From: 4, Into: 5, Method: EvolveLevel, Level: 16},
+	{From: 5, Into: 6, Method: EvolveLevel, Level: 36},
+	{From: 7, Into: 8, Method: EvolveLevel, Level: 16},
+	{From: 8, Into: 9, Method: EvolveLevel, Level: 36},
+	{From: 10, Into: 11, Method: EvolveLevel, Level: 7},
+	{From: 11, Into: 12, Method: EvolveLevel, Level: 10},
+	{From: 13, Into: 14, Method: EvolveLevel, Level: 7},
+	{From: 14, Into: 15, Method: EvolveLevel, Level: 10},
+	{From: 16, Into: 17, Method: EvolveLevel, Level: 18},
+	{From: 17, Into: 18, Method: EvolveLevel, Level: 36},
+	{From: 19, Into: 20, Method: EvolveLevel, Level: 20},
+	{From: 21, Into: 22, Method: EvolveLevel, Level: 20},
+	{From: 23, Into: 24, Method: EvolveLevel, Level: 22},
+	{From: 25, Into: 26, Method: EvolveItem, Item: ThunderStone},
+	{From: 27, Into: 28, Method: EvolveLevel, Level: 22},
+	{From: 29, Into: 30, Method: EvolveLevel, Level: 16},
+	{From: 30, Into: 31, Method: EvolveItem, Item: MoonStone},
+	{From: 32, Into: 33, Method: EvolveLevel, Level: 16},
+	{From: 33, Into: 34, Method: EvolveItem, Item: MoonStone},
+	{From: 35, Into: 36, Method: EvolveItem, Item: MoonStone},
+	{From: 37, Into: 38, Method: EvolveItem, Item: FireStone},
+	{From: 39, Into: 40, Method: EvolveItem, Item: MoonStone},
+	{From: 41, Into: 42, Method: EvolveLevel, Level: 22},
+	{From: 43, Into: 44, Method: EvolveLevel, Level: 21},
+	{From: 44, Into: 45, Method: EvolveItem, Item: LeafStone},
+	{From: 46, Into: 47, Method: EvolveLevel, Level: 24},
+	{From: 48, Into: 49, Method: EvolveLevel, Level: 31},
+	{From: 50, Into: 51, Method: EvolveLevel, Level: 26},
+	{From: 52, Into: 53, Method: EvolveLevel, Level: 28},
+	{From: 54, Into: 55, Method: EvolveLevel, Level: 33},
+	{From: 56, Into: 57, Method: EvolveLevel, Level: 28},
+	{From: 58, Into: 59, Method: EvolveItem, Item: FireStone},
+	{From: 60, Into: 61, Method: EvolveLevel, Level: 25},
+	{From: 61, Into: 62, Method: EvolveItem, Item: WaterStone},
+	{From: 63, Into: 64, Method: EvolveLevel, Level: 16},
+	{From: 64, Into: 65, Method: EvolveTrade},
+	{From: 66, Into: 67, Method: EvolveLevel, Level: 28},
+	{From: 67, Into: 68, Method: EvolveTrade},
+	{From: 69, Into: 70, Method: EvolveLevel, Level: 21},
+	{From: 70, Into: 71, Method: EvolveItem, Item: LeafStone},
+	{From: 72, Into: 73, Method: EvolveLevel, Level: 30},
+	{From: 74, Into: 75, Method: EvolveLevel, Level: 25},
+	{From: 75, Into: 76, Method: EvolveTrade},
+	{From: 77, Into: 78, Method: EvolveLevel, Level: 40},
+	{From: 79, Into: 80, Method: EvolveLevel, Level: 37},
+	{From: 81, Into: 82, Method: EvolveLevel, Level: 30},
+	{From: 84, Into: 85, Method: EvolveLevel, Level: 31},
+	{From: 86, Into: 87, Method: EvolveLevel, Level: 34},
+	{From: 88, Into: 89, Method: EvolveLevel, Level: 38},
+	{From: 90, Into: 91, Method: EvolveItem, Item: WaterStone},
+	{From: 92, Into: 93, Method: EvolveLevel, Level: 25},
+	{From: 93, Into: 94, Method: EvolveTrade},
+	{From: 96, Into: 97, Method: EvolveLevel, Level: 26},
+	{From: 98, Into: 99, Method: EvolveLevel, Level: 28},
+	{From: 100, Into: 101, Method: EvolveLevel, Level: 30},
+	{From: 102, Into: 103, Method: EvolveItem, Item: LeafStone},
+	{From: 104, Into: 105, Method: EvolveLevel, Level: 28},
+	{From: 109, Into: 110, Method: EvolveLevel, Level: 35},
+	{From: 111, Into: 112, Method: EvolveLevel, Level: 42},
+	{From: 116, Into: 117, Method: EvolveLevel, Level: 32},
+	{From: 118, Into: 119, Method: EvolveLevel, Level: 33},
+	{From: 120, Into: 121, Method: EvolveItem, Item: WaterStone},
+	{From: 129, Into: 130, Method: EvolveLevel, Level: 20},
+	{From: 133, Into: 134, Method: EvolveItem, Item: WaterStone},
+	{From: 133, Into: 135, Method: EvolveItem, Item: ThunderStone},
+	{From: 133, Into: 136, Method: EvolveItem, Item: FireStone},
+	{From: 138, Into: 139, Method: EvolveLevel, Level: 40},
+	{From: 140, Into: 141, Method: EvolveLevel, Level: 40},
+	{From: 147, Into: 148, Method: EvolveLevel, Level: 30},
+	{From: 148, Into: 149, Method: EvolveLevel, Level: 55},
+}
+
+// EvolvesFrom returns the evolution that produces the species with the given Pokédex number.
+// Gen 1 species evolve from at most one other species, so there is at most one.
+func EvolvesFrom(dex int) (Evolution, bool) {
+	for _, e := range evolutions {
+		if e.Into == dex {
+			return e, true
+		}
+	}
+	return Evolution{}, false
+}
+
+// EvolvesInto returns the evolutions available to the species with the given Pokédex number.
+func EvolvesInto(dex int) []Evolution {
+	var into []Evolution
+	for _, e := range evolutions {
+		if e.From == dex {
+			into = append(into, e)
+		}
+	}
+	return into
+}
+
+// Stage returns the species' stage in its evolution line: 1 for species that evolve from none,
+// 2 for species that evolve from those, and 3 for species that evolve from a stage 2 species.
+func Stage(dex int) int {
+	stage := 1
+	for {
+		e, ok := EvolvesFrom(dex)
+		if !ok {
+			return stage
+		}
+		dex = e.From
+		stage++
+	}
+}
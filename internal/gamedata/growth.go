@@ -0,0 +1,42 @@
+package gamedata
+
+// GrowthRate determines how much experience a species needs to reach each level.
+type GrowthRate byte
+
+const (
+	MediumFast GrowthRate = 0
+	MediumSlow GrowthRate = 3
+	Fast       GrowthRate = 4
+	Slow       GrowthRate = 5
+)
+
+// ExpForLevel returns the experience needed to reach level.
+func (g GrowthRate) ExpForLevel(level int) uint32 {
+	n := level
+	var exp int
+	switch g {
+	case MediumSlow:
+		exp = 6*n*n*n/5 - 15*n*n + 100*n - 140
+	case Fast:
+		exp = 4 * n * n * n / 5
+	case Slow:
+		exp = 5 * n * n * n / 4
+	default:
+		exp = n * n * n
+	}
+
+	// Medium slow is negative at level 1; the game clamps it to zero.
+	if exp < 0 || level <= 1 {
+		return 0
+	}
+	return uint32(exp)
+}
+
+// LevelForExp returns the highest level, up to 100, reached with the given experience.
+func (g GrowthRate) LevelForExp(exp uint32) int {
+	level := 1
+	for level < 100 && g.ExpForLevel(level+1) <= exp {
+		level++
+	}
+	return level
+}
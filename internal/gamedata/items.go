@@ -0,0 +1,121 @@
+package gamedata
+
+import "fmt"
+
+// Item describes an item. ID is the item index written to save files.
+type Item struct {
+	ID   byte
+	Name string
+}
+
+var items = []Item{
+	{ID: 0x01, Name: "MASTER BALL"},
+	{ID: 0x02, Name: "ULTRA BALL"},
+	{ID: 0x03, Name: "GREAT BALL"},
+	{ID: 0x04, Name: "POKé BALL"},
+	{ID: 0x05, Name: "TOWN MAP"},
+	{ID: 0x06, Name: "BICYCLE"},
+	{ID: 0x07, Name: "SURFBOARD"},
+	{ID: 0x08, Name: "SAFARI BALL"},
+	{ID: 0x09, Name: "POKéDEX"},
+	{ID: 0x0A, Name: "MOON STONE"},
+	{ID: 0x0B, Name: "ANTIDOTE"},
+	{ID: 0x0C, Name: "BURN HEAL"},
+	{ID: 0x0D, Name: "ICE HEAL"},
+	{ID: 0x0E, Name: "AWAKENING"},
+	{ID: 0x0F, Name: "PARLYZ HEAL"},
+	{ID: 0x10, Name: "FULL RESTORE"},
+	{ID: 0x11, Name: "MAX POTION"},
+	{ID: 0x12, Name: "HYPER POTION"},
+	{ID: 0x13, Name: "SUPER POTION"},
+	{ID: 0x14, Name: "POTION"},
+	{ID: 0x15, Name: "BOULDERBADGE"},
+	{ID: 0x16, Name: "CASCADEBADGE"},
+	{ID: 0x17, Name: "THUNDERBADGE"},
+	{ID: 0x18, Name: "RAINBOWBADGE"},
+	{ID: 0x19, Name: "SOULBADGE"},
+	{ID: 0x1A, Name: "MARSHBADGE"},
+	{ID: 0x1B, Name: "VOLCANOBADGE"},
+	{ID: 0x1C, Name: "EARTHBADGE"},
+	{ID: 0x1D, Name: "ESCAPE ROPE"},
+	{ID: 0x1E, Name: "REPEL"},
+	{ID: 0x1F, Name: "OLD AMBER"},
+	{ID: 0x20, Name: "FIRE STONE"},
+	{ID: 0x21, Name: "THUNDERSTONE"},
+	{ID: 0x22, Name: "WATER STONE"},
+	{ID: 0x23, Name: "HP UP"},
+	{ID: 0x24, Name: "PROTEIN"},
+	{ID: 0x25, Name: "IRON"},
+	{ID: 0x26, Name: "CARBOS"},
+	{ID: 0x27, Name: "CALCIUM"},
+	{ID: 0x28, Name: "RARE CANDY"},
+	{ID: 0x29, Name: "DOME FOSSIL"},
+	{ID: 0x2A, Name: "HELIX FOSSIL"},
+	{ID: 0x2B, Name: "SECRET KEY"},
+	{ID: 0x2C, Name: "UNUSED ITEM"},
+	{ID: 0x2D, Name: "BIKE VOUCHER"},
+	{ID: 0x2E, Name: "X ACCURACY"},
+	{ID: 0x2F, Name: "LEAF STONE"},
+	{ID: 0x30, Name: "CARD KEY"},
+	{ID: 0x31, Name: "NUGGET"},
+	{ID: 0x32, Name: "PP UP"},
+	{ID: 0x33, Name: "POKé DOLL"},
+	{ID: 0x34, Name: "FULL HEAL"},
+	{ID: 0x35, Name: "REVIVE"},
+	{ID: 0x36, Name: "MAX REVIVE"},
+	{ID: 0x37, Name: "GUARD SPEC."},
+	{ID: 0x38, Name: "SUPER REPEL"},
+	{ID: 0x39, Name: "MAX REPEL"},
+	{ID: 0x3A, Name: "DIRE HIT"},
+	{ID: 0x3B, Name: "COIN"},
+	{ID: 0x3C, Name: "FRESH WATER"},
+	{ID: 0x3D, Name: "SODA POP"},
+	{ID: 0x3E, Name: "LEMONADE"},
+	{ID: 0x3F, Name: "S.S.TICKET"},
+	{ID: 0x40, Name: "GOLD TEETH"},
+	{ID: 0x41, Name: "X ATTACK"},
+	{ID: 0x42, Name: "X DEFEND"},
+	{ID: 0x43, Name: "X SPEED"},
+	{ID: 0x44, Name: "X SPECIAL"},
+	{ID: 0x45, Name: "COIN CASE"},
+	{ID: 0x46, Name: "OAK'S PARCEL"},
+	{ID: 0x47, Name: "ITEMFINDER"},
+	{ID: 0x48, Name: "SILPH SCOPE"},
+	{ID: 0x49, Name: "POKé FLUTE"},
+	{ID: 0x4A, Name: "LIFT KEY"},
+	{ID: 0x4B, Name: "EXP.ALL"},
+	{ID: 0x4C, Name: "OLD ROD"},
+	{ID: 0x4D, Name: "GOOD ROD"},
+	{ID: 0x4E, Name: "SUPER ROD"},
+	{ID: 0x4F, Name: "PP UP"},
+	{ID: 0x50, Name: "ETHER"},
+	{ID: 0x51, Name: "MAX ETHER"},
+	{ID: 0x52, Name: "ELIXER"},
+	{ID: 0x53, Name: "MAX ELIXER"},
+}
+
+// ItemByID returns the item with the given index.
+// Indices 0xC4 to 0xC8 are HMs and 0xC9 to 0xFA are TMs.
+func ItemByID(id byte) (Item, bool) {
+	switch {
+	case id >= 0xC4 && id <= 0xC8:
+		return Item{ID: id, Name: fmt.Sprintf("HM%02d", id-0xC3)}, true
+	case id >= 0xC9 && id <= 0xFA:
+		return Item{ID: id, Name: fmt.Sprintf("TM%02d", id-0xC8)}, true
+	case id == 0 || int(id) > len(items):
+		return Item{}, false
+	}
+	return items[id-1], true
+}
+
+// ItemByName returns the item with the given name, ignoring case, spaces and punctuation.
+// TMs and HMs are named as in ItemByID, such as TM01 or HM03.
+func ItemByName(name string) (Item, bool) {
+	for id := 1; id <= 0xFA; id++ {
+		item, ok := ItemByID(byte(id))
+		if ok && NormaliseName(item.Name) == NormaliseName(name) {
+			return item, true
+		}
+	}
+	return Item{}, false
+}
@@ -0,0 +1,247 @@
+package gamedata
+
+var maps = map[byte]string{
+	0x00: "PALLET TOWN",
+	0x01: "VIRIDIAN CITY",
+	0x02: "PEWTER CITY",
+	0x03: "CERULEAN CITY",
+	0x04: "LAVENDER TOWN",
+	0x05: "VERMILION CITY",
+	0x06: "CELADON CITY",
+	0x07: "FUCHSIA CITY",
+	0x08: "CINNABAR ISLAND",
+	0x09: "INDIGO PLATEAU",
+	0x0A: "SAFFRON CITY",
+	0x0C: "ROUTE 1",
+	0x0D: "ROUTE 2",
+	0x0E: "ROUTE 3",
+	0x0F: "ROUTE 4",
+	0x10: "ROUTE 5",
+	0x11: "ROUTE 6",
+	0x12: "ROUTE 7",
+	0x13: "ROUTE 8",
+	0x14: "ROUTE 9",
+	0x15: "ROUTE 10",
+	0x16: "ROUTE 11",
+	0x17: "ROUTE 12",
+	0x18: "ROUTE 13",
+	0x19: "ROUTE 14",
+	0x1A: "ROUTE 15",
+	0x1B: "ROUTE 16",
+	0x1C: "ROUTE 17",
+	0x1D: "ROUTE 18",
+	0x1E: "ROUTE 19",
+	0x1F: "ROUTE 20",
+	0x20: "ROUTE 21",
+	0x21: "ROUTE 22",
+	0x22: "ROUTE 23",
+	0x23: "ROUTE 24",
+	0x24: "ROUTE 25",
+	0x25: "RED'S HOUSE 1F",
+	0x26: "RED'S HOUSE 2F",
+	0x27: "BLUE'S HOUSE",
+	0x28: "OAK'S LAB",
+	0x29: "VIRIDIAN POKéCENTER",
+	0x2A: "VIRIDIAN MART",
+	0x2B: "VIRIDIAN SCHOOL HOUSE",
+	0x2C: "VIRIDIAN NICKNAME HOUSE",
+	0x2D: "VIRIDIAN GYM",
+	0x2E: "DIGLETT'S CAVE ROUTE 2",
+	0x2F: "VIRIDIAN FOREST NORTH GATE",
+	0x30: "ROUTE 2 TRADE HOUSE",
+	0x31: "ROUTE 2 GATE",
+	0x32: "VIRIDIAN FOREST SOUTH GATE",
+	0x33: "VIRIDIAN FOREST",
+	0x34: "MUSEUM 1F",
+	0x35: "MUSEUM 2F",
+	0x36: "PEWTER GYM",
+	0x37: "PEWTER NIDORAN HOUSE",
+	0x38: "PEWTER MART",
+	0x39: "PEWTER SPEECH HOUSE",
+	0x3A: "PEWTER POKéCENTER",
+	0x3B: "MT. MOON 1F",
+	0x3C: "MT. MOON B1F",
+	0x3D: "MT. MOON B2F",
+	0x3E: "CERULEAN TRASHED HOUSE",
+	0x3F: "CERULEAN TRADE HOUSE",
+	0x40: "CERULEAN POKéCENTER",
+	0x41: "CERULEAN GYM",
+	0x42: "BIKE SHOP",
+	0x43: "CERULEAN MART",
+	0x44: "MT. MOON POKéCENTER",
+	0x45: "CERULEAN TRASHED HOUSE",
+	0x46: "ROUTE 5 GATE",
+	0x47: "UNDERGROUND PATH ROUTE 5",
+	0x48: "DAYCARE",
+	0x49: "ROUTE 6 GATE",
+	0x4A: "UNDERGROUND PATH ROUTE 6",
+	0x4B: "UNDERGROUND PATH ROUTE 6",
+	0x4C: "ROUTE 7 GATE",
+	0x4D: "UNDERGROUND PATH ROUTE 7",
+	0x4E: "UNDERGROUND PATH ROUTE 7",
+	0x4F: "ROUTE 8 GATE",
+	0x50: "UNDERGROUND PATH ROUTE 8",
+	0x51: "ROCK TUNNEL POKéCENTER",
+	0x52: "ROCK TUNNEL 1F",
+	0x53: "POWER PLANT",
+	0x54: "ROUTE 11 GATE 1F",
+	0x55: "DIGLETT'S CAVE ROUTE 11",
+	0x56: "ROUTE 11 GATE 2F",
+	0x57: "ROUTE 12 GATE 1F",
+	0x58: "BILL'S HOUSE",
+	0x59: "VERMILION POKéCENTER",
+	0x5A: "POKéMON FAN CLUB",
+	0x5B: "VERMILION MART",
+	0x5C: "VERMILION GYM",
+	0x5D: "VERMILION PIDGEY HOUSE",
+	0x5E: "VERMILION DOCK",
+	0x5F: "S.S.ANNE 1F",
+	0x60: "S.S.ANNE 2F",
+	0x61: "S.S.ANNE 3F",
+	0x62: "S.S.ANNE B1F",
+	0x63: "S.S.ANNE BOW",
+	0x64: "S.S.ANNE KITCHEN",
+	0x65: "S.S.ANNE CAPTAIN'S ROOM",
+	0x66: "S.S.ANNE 1F ROOMS",
+	0x67: "S.S.ANNE 2F ROOMS",
+	0x68: "S.S.ANNE B1F ROOMS",
+	0x6C: "VICTORY ROAD 1F",
+	0x71: "LANCE'S ROOM",
+	0x76: "HALL OF FAME",
+	0x77: "UNDERGROUND PATH NORTH-SOUTH",
+	0x78: "CHAMPION'S ROOM",
+	0x79: "UNDERGROUND PATH WEST-EAST",
+	0x7A: "CELADON MART 1F",
+	0x7B: "CELADON MART 2F",
+	0x7C: "CELADON MART 3F",
+	0x7D: "CELADON MART 4F",
+	0x7E: "CELADON MART ROOF",
+	0x7F: "CELADON MART ELEVATOR",
+	0x80: "CELADON MANSION 1F",
+	0x81: "CELADON MANSION 2F",
+	0x82: "CELADON MANSION 3F",
+	0x83: "CELADON MANSION ROOF",
+	0x84: "CELADON MANSION ROOF HOUSE",
+	0x85: "CELADON POKéCENTER",
+	0x86: "CELADON GYM",
+	0x87: "GAME CORNER",
+	0x88: "CELADON MART 5F",
+	0x89: "GAME CORNER PRIZE ROOM",
+	0x8A: "CELADON DINER",
+	0x8B: "CELADON CHIEF HOUSE",
+	0x8C: "CELADON HOTEL",
+	0x8D: "LAVENDER POKéCENTER",
+	0x8E: "POKéMON TOWER 1F",
+	0x8F: "POKéMON TOWER 2F",
+	0x90: "POKéMON TOWER 3F",
+	0x91: "POKéMON TOWER 4F",
+	0x92: "POKéMON TOWER 5F",
+	0x93: "POKéMON TOWER 6F",
+	0x94: "POKéMON TOWER 7F",
+	0x95: "MR.FUJI'S HOUSE",
+	0x96: "LAVENDER MART",
+	0x97: "LAVENDER CUBONE HOUSE",
+	0x98: "FUCHSIA MART",
+	0x99: "FUCHSIA BILL'S GRANDPA'S HOUSE",
+	0x9A: "FUCHSIA POKéCENTER",
+	0x9B: "WARDEN'S HOUSE",
+	0x9C: "SAFARI ZONE GATE",
+	0x9D: "FUCHSIA GYM",
+	0x9E: "FUCHSIA MEETING ROOM",
+	0x9F: "SEAFOAM ISLANDS B1F",
+	0xA0: "SEAFOAM ISLANDS B2F",
+	0xA1: "SEAFOAM ISLANDS B3F",
+	0xA2: "SEAFOAM ISLANDS B4F",
+	0xA3: "VERMILION OLD ROD HOUSE",
+	0xA4: "FUCHSIA GOOD ROD HOUSE",
+	0xA5: "POKéMON MANSION 1F",
+	0xA6: "CINNABAR GYM",
+	0xA7: "CINNABAR LAB",
+	0xA8: "CINNABAR LAB TRADE ROOM",
+	0xA9: "CINNABAR LAB METRONOME ROOM",
+	0xAA: "CINNABAR LAB FOSSIL ROOM",
+	0xAB: "CINNABAR POKéCENTER",
+	0xAC: "CINNABAR MART",
+	0xAD: "CINNABAR MART",
+	0xAE: "INDIGO PLATEAU LOBBY",
+	0xAF: "COPYCAT'S HOUSE 1F",
+	0xB0: "COPYCAT'S HOUSE 2F",
+	0xB1: "FIGHTING DOJO",
+	0xB2: "SAFFRON GYM",
+	0xB3: "SAFFRON PIDGEY HOUSE",
+	0xB4: "SAFFRON MART",
+	0xB5: "SILPH CO. 1F",
+	0xB6: "SAFFRON POKéCENTER",
+	0xB7: "MR.PSYCHIC'S HOUSE",
+	0xB8: "ROUTE 15 GATE 1F",
+	0xB9: "ROUTE 15 GATE 2F",
+	0xBA: "ROUTE 16 GATE 1F",
+	0xBB: "ROUTE 16 GATE 2F",
+	0xBC: "ROUTE 16 FLY HOUSE",
+	0xBD: "ROUTE 12 SUPER ROD HOUSE",
+	0xBE: "ROUTE 18 GATE 1F",
+	0xBF: "ROUTE 18 GATE 2F",
+	0xC0: "SEAFOAM ISLANDS 1F",
+	0xC1: "ROUTE 22 GATE",
+	0xC2: "VICTORY ROAD 2F",
+	0xC3: "ROUTE 12 GATE 2F",
+	0xC4: "VERMILION TRADE HOUSE",
+	0xC5: "DIGLETT'S CAVE",
+	0xC6: "VICTORY ROAD 3F",
+	0xC7: "ROCKET HIDEOUT B1F",
+	0xC8: "ROCKET HIDEOUT B2F",
+	0xC9: "ROCKET HIDEOUT B3F",
+	0xCA: "ROCKET HIDEOUT B4F",
+	0xCB: "ROCKET HIDEOUT ELEVATOR",
+	0xCF: "SILPH CO. 2F",
+	0xD0: "SILPH CO. 3F",
+	0xD1: "SILPH CO. 4F",
+	0xD2: "SILPH CO. 5F",
+	0xD3: "SILPH CO. 6F",
+	0xD4: "SILPH CO. 7F",
+	0xD5: "SILPH CO. 8F",
+	0xD6: "POKéMON MANSION 2F",
+	0xD7: "POKéMON MANSION 3F",
+	0xD8: "POKéMON MANSION B1F",
+	0xD9: "SAFARI ZONE EAST",
+	0xDA: "SAFARI ZONE NORTH",
+	0xDB: "SAFARI ZONE WEST",
+	0xDC: "SAFARI ZONE CENTER",
+	0xDD: "SAFARI ZONE CENTER REST HOUSE",
+	0xDE: "SAFARI ZONE SECRET HOUSE",
+	0xDF: "SAFARI ZONE WEST REST HOUSE",
+	0xE0: "SAFARI ZONE EAST REST HOUSE",
+	0xE1: "SAFARI ZONE NORTH REST HOUSE",
+	0xE2: "CERULEAN CAVE 2F",
+	0xE3: "CERULEAN CAVE B1F",
+	0xE4: "CERULEAN CAVE 1F",
+	0xE5: "NAME RATER'S HOUSE",
+	0xE6: "CERULEAN BADGE HOUSE",
+	0xE8: "ROCK TUNNEL B1F",
+	0xE9: "SILPH CO. 9F",
+	0xEA: "SILPH CO. 10F",
+	0xEB: "SILPH CO. 11F",
+	0xEC: "SILPH CO. ELEVATOR",
+	0xEF: "TRADE CENTER",
+	0xF0: "COLOSSEUM",
+	0xF5: "LORELEI'S ROOM",
+	0xF6: "BRUNO'S ROOM",
+	0xF7: "AGATHA'S ROOM",
+}
+
+// MapName returns the name of the map with the given index.
+func MapName(id byte) (string, bool) {
+	name, ok := maps[id]
+	return name, ok
+}
+
+// MapByName returns the index of the map with the given name, ignoring case, spaces and punctuation.
+// A few names are shared by an unused copy of a map, so the lowest index is returned.
+func MapByName(name string) (byte, bool) {
+	for id := 0; id <= 0xFF; id++ {
+		if mapName, ok := maps[byte(id)]; ok && NormaliseName(mapName) == NormaliseName(name) {
+			return byte(id), true
+		}
+	}
+	return 0, false
+}
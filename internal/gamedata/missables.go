@@ -0,0 +1,58 @@
+package gamedata
+
+// missableObjects names the missable objects that pokegen knows about in Red and Blue, keyed by their number in the games' hide and show table.
+var missableObjects = map[int]string{
+	0x01: "LYING_OLD_MAN",
+	0x02: "OLD_MAN",
+	0x27: "DAISY_SITTING",
+	0x28: "DAISY_WALKING",
+	0x29: "TOWN_MAP",
+	0x2A: "OAKS_LAB_RIVAL",
+	0x2B: "STARTER_BALL_1",
+	0x2C: "STARTER_BALL_2",
+	0x2D: "STARTER_BALL_3",
+	0x2E: "OAKS_LAB_OAK_1",
+	0x2F: "POKEDEX_1",
+	0x30: "POKEDEX_2",
+	0x31: "OAKS_LAB_OAK_2",
+}
+
+// yellowMissableObjects are the missable objects of Yellow's hide and show table.
+// Oak's lab holds a single Poké Ball, the rival's Eevee, where Red and Blue's holds three,
+// so the objects that follow it are numbered two lower.
+var yellowMissableObjects = map[int]string{
+	0x01: "LYING_OLD_MAN",
+	0x02: "OLD_MAN",
+	0x27: "DAISY_SITTING",
+	0x28: "DAISY_WALKING",
+	0x29: "TOWN_MAP",
+	0x2A: "OAKS_LAB_RIVAL",
+	0x2B: "EEVEE_BALL",
+	0x2C: "OAKS_LAB_OAK_1",
+	0x2D: "POKEDEX_1",
+	0x2E: "POKEDEX_2",
+	0x2F: "OAKS_LAB_OAK_2",
+}
+
+func missableObjectsIn(v Version) map[int]string {
+	if v == VersionYellow {
+		return yellowMissableObjects
+	}
+	return missableObjects
+}
+
+// MissableObjectName returns the name of missable object n in the version.
+func MissableObjectName(n int, v Version) (string, bool) {
+	name, ok := missableObjectsIn(v)[n]
+	return name, ok
+}
+
+// MissableObjectByName returns the number of the missable object with the given name in the version.
+func MissableObjectByName(name string, v Version) (int, bool) {
+	for n, objectName := range missableObjectsIn(v) {
+		if objectName == name {
+			return n, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,195 @@
+package gamedata
+
+// Move describes a move. ID is the move index written to save files.
+type Move struct {
+	ID   byte
+	Name string
+	// PP is the move's base PP, before any PP Ups.
+	PP byte
+}
+
+var moves = []Move{
+	{ID: 0x01, Name: "POUND", PP: 35},
+	{ID: 0x02, Name: "KARATE CHOP", PP: 25},
+	{ID: 0x03, Name: "DOUBLESLAP", PP: 10},
+	{ID: 0x04, Name: "COMET PUNCH", PP: 15},
+	{ID: 0x05, Name: "MEGA PUNCH", PP: 20},
+	{ID: 0x06, Name: "PAY DAY", PP: 20},
+	{ID: 0x07, Name: "FIRE PUNCH", PP: 15},
+	{ID: 0x08, Name: "ICE PUNCH", PP: 15},
+	{ID: 0x09, Name: "THUNDERPUNCH", PP: 15},
+	{ID: 0x0A, Name: "SCRATCH", PP: 35},
+	{ID: 0x0B, Name: "VICEGRIP", PP: 30},
+	{ID: 0x0C, Name: "GUILLOTINE", PP: 5},
+	{ID: 0x0D, Name: "RAZOR WIND", PP: 10},
+	{ID: 0x0E, Name: "SWORDS DANCE", PP: 30},
+	{ID: 0x0F, Name: "CUT", PP: 30},
+	{ID: 0x10, Name: "GUST", PP: 35},
+	{ID: 0x11, Name: "WING ATTACK", PP: 35},
+	{ID: 0x12, Name: "WHIRLWIND", PP: 20},
+	{ID: 0x13, Name: "FLY", PP: 15},
+	{ID: 0x14, Name: "BIND", PP: 20},
+	{ID: 0x15, Name: "SLAM", PP: 20},
+	{ID: 0x16, Name: "VINE WHIP", PP: 10},
+	{ID: 0x17, Name: "STOMP", PP: 20},
+	{ID: 0x18, Name: "DOUBLE KICK", PP: 30},
+	{ID: 0x19, Name: "MEGA KICK", PP: 5},
+	{ID: 0x1A, Name: "JUMP KICK", PP: 25},
+	{ID: 0x1B, Name: "ROLLING KICK", PP: 15},
+	{ID: 0x1C, Name: "SAND-ATTACK", PP: 15},
+	{ID: 0x1D, Name: "HEADBUTT", PP: 15},
+	{ID: 0x1E, Name: "HORN ATTACK", PP: 25},
+	{ID: 0x1F, Name: "FURY ATTACK", PP: 20},
+	{ID: 0x20, Name: "HORN DRILL", PP: 5},
+	{ID: 0x21, Name: "TACKLE", PP: 35},
+	{ID: 0x22, Name: "BODY SLAM", PP: 15},
+	{ID: 0x23, Name: "WRAP", PP: 20},
+	{ID: 0x24, Name: "TAKE DOWN", PP: 20},
+	{ID: 0x25, Name: "THRASH", PP: 20},
+	{ID: 0x26, Name: "DOUBLE-EDGE", PP: 15},
+	{ID: 0x27, Name: "TAIL WHIP", PP: 30},
+	{ID: 0x28, Name: "POISON STING", PP: 35},
+	{ID: 0x29, Name: "TWINEEDLE", PP: 20},
+	{ID: 0x2A, Name: "PIN MISSILE", PP: 20},
+	{ID: 0x2B, Name: "LEER", PP: 30},
+	{ID: 0x2C, Name: "BITE", PP: 25},
+	{ID: 0x2D, Name: "GROWL", PP: 40},
+	{ID: 0x2E, Name: "ROAR", PP: 20},
+	{ID: 0x2F, Name: "SING", PP: 15},
+	{ID: 0x30, Name: "SUPERSONIC", PP: 20},
+	{ID: 0x31, Name: "SONICBOOM", PP: 20},
+	{ID: 0x32, Name: "DISABLE", PP: 20},
+	{ID: 0x33, Name: "ACID", PP: 30},
+	{ID: 0x34, Name: "EMBER", PP: 25},
+	{ID: 0x35, Name: "FLAMETHROWER", PP: 15},
+	{ID: 0x36, Name: "MIST", PP: 30},
+	{ID: 0x37, Name: "WATER GUN", PP: 25},
+	{ID: 0x38, Name: "HYDRO PUMP", PP: 5},
+	{ID: 0x39, Name: "SURF", PP: 15},
+	{ID: 0x3A, Name: "ICE BEAM", PP: 10},
+	{ID: 0x3B, Name: "BLIZZARD", PP: 5},
+	{ID: 0x3C, Name: "PSYBEAM", PP: 20},
+	{ID: 0x3D, Name: "BUBBLEBEAM", PP: 20},
+	{ID: 0x3E, Name: "AURORA BEAM", PP: 20},
+	{ID: 0x3F, Name: "HYPER BEAM", PP: 5},
+	{ID: 0x40, Name: "PECK", PP: 35},
+	{ID: 0x41, Name: "DRILL PECK", PP: 20},
+	{ID: 0x42, Name: "SUBMISSION", PP: 25},
+	{ID: 0x43, Name: "LOW KICK", PP: 20},
+	{ID: 0x44, Name: "COUNTER", PP: 20},
+	{ID: 0x45, Name: "SEISMIC TOSS", PP: 20},
+	{ID: 0x46, Name: "STRENGTH", PP: 15},
+	{ID: 0x47, Name: "ABSORB", PP: 20},
+	{ID: 0x48, Name: "MEGA DRAIN", PP: 10},
+	{ID: 0x49, Name: "LEECH SEED", PP: 10},
+	{ID: 0x4A, Name: "GROWTH", PP: 40},
+	{ID: 0x4B, Name: "RAZOR LEAF", PP: 25},
+	{ID: 0x4C, Name: "SOLARBEAM", PP: 10},
+	{ID: 0x4D, Name: "POISONPOWDER", PP: 35},
+	{ID: 0x4E, Name: "STUN SPORE", PP: 30},
+	{ID: 0x4F, Name: "SLEEP POWDER", PP: 15},
+	{ID: 0x50, Name: "PETAL DANCE", PP: 20},
+	{ID: 0x51, Name: "STRING SHOT", PP: 40},
+	{ID: 0x52, Name: "DRAGON RAGE", PP: 10},
+	{ID: 0x53, Name: "FIRE SPIN", PP: 15},
+	{ID: 0x54, Name: "THUNDERSHOCK", PP: 30},
+	{ID: 0x55, Name: "THUNDERBOLT", PP: 15},
+	{ID: 0x56, Name: "THUNDER WAVE", PP: 20},
+	{ID: 0x57, Name: "THUNDER", PP: 10},
+	{ID: 0x58, Name: "ROCK THROW", PP: 15},
+	{ID: 0x59, Name: "EARTHQUAKE", PP: 10},
+	{ID: 0x5A, Name: "FISSURE", PP: 5},
+	{ID: 0x5B, Name: "DIG", PP: 10},
+	{ID: 0x5C, Name: "TOXIC", PP: 10},
+	{ID: 0x5D, Name: "CONFUSION", PP: 25},
+	{ID: 0x5E, Name: "PSYCHIC", PP: 10},
+	{ID: 0x5F, Name: "HYPNOSIS", PP: 20},
+	{ID: 0x60, Name: "MEDITATE", PP: 40},
+	{ID: 0x61, Name: "AGILITY", PP: 30},
+	{ID: 0x62, Name: "QUICK ATTACK", PP: 30},
+	{ID: 0x63, Name: "RAGE", PP: 20},
+	{ID: 0x64, Name: "TELEPORT", PP: 20},
+	{ID: 0x65, Name: "NIGHT SHADE", PP: 15},
+	{ID: 0x66, Name: "MIMIC", PP: 10},
+	{ID: 0x67, Name: "SCREECH", PP: 40},
+	{ID: 0x68, Name: "DOUBLE TEAM", PP: 15},
+	{ID: 0x69, Name: "RECOVER", PP: 20},
+	{ID: 0x6A, Name: "HARDEN", PP: 30},
+	{ID: 0x6B, Name: "MINIMIZE", PP: 20},
+	{ID: 0x6C, Name: "SMOKESCREEN", PP: 20},
+	{ID: 0x6D, Name: "CONFUSE RAY", PP: 10},
+	{ID: 0x6E, Name: "WITHDRAW", PP: 40},
+	{ID: 0x6F, Name: "DEFENSE CURL", PP: 40},
+	{ID: 0x70, Name: "BARRIER", PP: 30},
+	{ID: 0x71, Name: "LIGHT SCREEN", PP: 30},
+	{ID: 0x72, Name: "HAZE", PP: 30},
+	{ID: 0x73, Name: "REFLECT", PP: 20},
+	{ID: 0x74, Name: "FOCUS ENERGY", PP: 30},
+	{ID: 0x75, Name: "BIDE", PP: 10},
+	{ID: 0x76, Name: "METRONOME", PP: 10},
+	{ID: 0x77, Name: "MIRROR MOVE", PP: 20},
+	{ID: 0x78, Name: "SELFDESTRUCT", PP: 5},
+	{ID: 0x79, Name: "EGG BOMB", PP: 10},
+	{ID: 0x7A, Name: "LICK", PP: 30},
+	{ID: 0x7B, Name: "SMOG", PP: 20},
+	{ID: 0x7C, Name: "SLUDGE", PP: 20},
+	{ID: 0x7D, Name: "BONE CLUB", PP: 20},
+	{ID: 0x7E, Name: "FIRE BLAST", PP: 5},
+	{ID: 0x7F, Name: "WATERFALL", PP: 15},
+	{ID: 0x80, Name: "CLAMP", PP: 10},
+	{ID: 0x81, Name: "SWIFT", PP: 20},
+	{ID: 0x82, Name: "SKULL BASH", PP: 15},
+	{ID: 0x83, Name: "SPIKE CANNON", PP: 15},
+	{ID: 0x84, Name: "CONSTRICT", PP: 35},
+	{ID: 0x85, Name: "AMNESIA", PP: 20},
+	{ID: 0x86, Name: "KINESIS", PP: 15},
+	{ID: 0x87, Name: "SOFTBOILED", PP: 10},
+	{ID: 0x88, Name: "HI JUMP KICK", PP: 20},
+	{ID: 0x89, Name: "GLARE", PP: 30},
+	{ID: 0x8A, Name: "DREAM EATER", PP: 15},
+	{ID: 0x8B, Name: "POISON GAS", PP: 40},
+	{ID: 0x8C, Name: "BARRAGE", PP: 20},
+	{ID: 0x8D, Name: "LEECH LIFE", PP: 15},
+	{ID: 0x8E, Name: "LOVELY KISS", PP: 10},
+	{ID: 0x8F, Name: "SKY ATTACK", PP: 5},
+	{ID: 0x90, Name: "TRANSFORM", PP: 10},
+	{ID: 0x91, Name: "BUBBLE", PP: 30},
+	{ID: 0x92, Name: "DIZZY PUNCH", PP: 10},
+	{ID: 0x93, Name: "SPORE", PP: 15},
+	{ID: 0x94, Name: "FLASH", PP: 20},
+	{ID: 0x95, Name: "PSYWAVE", PP: 15},
+	{ID: 0x96, Name: "SPLASH", PP: 40},
+	{ID: 0x97, Name: "ACID ARMOR", PP: 40},
+	{ID: 0x98, Name: "CRABHAMMER", PP: 10},
+	{ID: 0x99, Name: "EXPLOSION", PP: 5},
+	{ID: 0x9A, Name: "FURY SWIPES", PP: 15},
+	{ID: 0x9B, Name: "BONEMERANG", PP: 10},
+	{ID: 0x9C, Name: "REST", PP: 10},
+	{ID: 0x9D, Name: "ROCK SLIDE", PP: 10},
+	{ID: 0x9E, Name: "HYPER FANG", PP: 15},
+	{ID: 0x9F, Name: "SHARPEN", PP: 30},
+	{ID: 0xA0, Name: "CONVERSION", PP: 30},
+	{ID: 0xA1, Name: "TRI ATTACK", PP: 10},
+	{ID: 0xA2, Name: "SUPER FANG", PP: 10},
+	{ID: 0xA3, Name: "SLASH", PP: 20},
+	{ID: 0xA4, Name: "SUBSTITUTE", PP: 10},
+	{ID: 0xA5, Name: "STRUGGLE", PP: 10},
+}
+
+// MoveByID returns the move with the given index.
+func MoveByID(id byte) (Move, bool) {
+	if id == 0 || int(id) > len(moves) {
+		return Move{}, false
+	}
+	return moves[id-1], true
+}
+
+// MoveByName returns the move with the given name, ignoring case, spaces and punctuation.
+func MoveByName(name string) (Move, bool) {
+	for _, m := range moves {
+		if NormaliseName(m.Name) == NormaliseName(name) {
+			return m, true
+		}
+	}
+	return Move{}, false
+}
@@ -0,0 +1,25 @@
+package gamedata
+
+import (
+	"strings"
+	"unicode"
+)
+
+// NormaliseName reduces a name to lowercase letters and digits so names written by people match the game's,
+// such as "Mr. Mime" and "MR.MIME", "Nidoran-F" and "NIDORAN♀", or "Poke Ball" and "POKé BALL".
+func NormaliseName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r == '♀':
+			b.WriteRune('f')
+		case r == '♂':
+			b.WriteRune('m')
+		case r == 'é':
+			b.WriteRune('e')
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,221 @@
+package gamedata
+
+// Species describes a Pokémon species as stored by the Gen 1 games.
+// Index is the internal species index written to save files, which differs from the Pokédex number.
+// Single-typed species store their type twice.
+type Species struct {
+	Dex        int
+	Index      byte
+	Name       string
+	Types      [2]Type
+	BaseStats  BaseStats
+	CatchRate  byte
+	GrowthRate GrowthRate
+}
+
+// BaseStats are a species' base values for each stat.
+type BaseStats struct {
+	HP      int
+	Attack  int
+	Defense int
+	Speed   int
+	Special int
+}
+
+var species = []Species{
+	{Dex: 1, Index: 0x99, Name: "BULBASAUR", Types: [2]Type{Grass, Poison}, BaseStats: BaseStats{HP: 45, Attack: 49, Defense: 49, Speed: 45, Special: 65}, CatchRate: 45, GrowthRate: MediumSlow},
+	{Dex: 2, Index: 0x09, Name: "IVYSAUR", Types: [2]Type{Grass, Poison}, BaseStats: BaseStats{HP: 60, Attack: 62, Defense: 63, Speed: 60, Special: 80}, CatchRate: 45, GrowthRate: MediumSlow},
+	{Dex: 3, Index: 0x9A, Name: "VENUSAUR", Types: [2]Type{Grass, Poison}, BaseStats: BaseStats{HP: 80, Attack: 82, Defense: 83, Speed: 80, Special: 100}, CatchRate: 45, GrowthRate: MediumSlow},
+	{Dex: 4, Index: 0xB0, Name: "CHARMANDER", Types: [2]Type{Fire, Fire}, BaseStats: BaseStats{HP: 39, Attack: 52, Defense: 43, Speed: 65, Special: 50}, CatchRate: 45, GrowthRate: MediumSlow},
+	{Dex: 5, Index: 0xB2, Name: "CHARMELEON", Types: [2]Type{Fire, Fire}, BaseStats: BaseStats{HP: 58, Attack: 64, Defense: 58, Speed: 80, Special: 65}, CatchRate: 45, GrowthRate: MediumSlow},
+	{Dex: 6, Index: 0xB4, Name: "CHARIZARD", Types: [2]Type{Fire, Flying}, BaseStats: BaseStats{HP: 78, Attack: 84, Defense: 78, Speed: 100, Special: 85}, CatchRate: 45, GrowthRate: MediumSlow},
+	{Dex: 7, Index: 0xB1, Name: "SQUIRTLE", Types: [2]Type{Water, Water}, BaseStats: BaseStats{HP: 44, Attack: 48, Defense: 65, Speed: 43, Special: 50}, CatchRate: 45, GrowthRate: MediumSlow},
+	{Dex: 8, Index: 0xB3, Name: "WARTORTLE", Types: [2]Type{Water, Water}, BaseStats: BaseStats{HP: 59, Attack: 63, Defense: 80, Speed: 58, Special: 65}, CatchRate: 45, GrowthRate: MediumSlow},
+	{Dex: 9, Index: 0x1C, Name: "BLASTOISE", Types: [2]Type{Water, Water}, BaseStats: BaseStats{HP: 79, Attack: 83, Defense: 100, Speed: 78, Special: 85}, CatchRate: 45, GrowthRate: MediumSlow},
+	{Dex: 10, Index: 0x7B, Name: "CATERPIE", Types: [2]Type{Bug, Bug}, BaseStats: BaseStats{HP: 45, Attack: 30, Defense: 35, Speed: 45, Special: 20}, CatchRate: 255, GrowthRate: MediumFast},
+	{Dex: 11, Index: 0x7C, Name: "METAPOD", Types: [2]Type{Bug, Bug}, BaseStats: BaseStats{HP: 50, Attack: 20, Defense: 55, Speed: 30, Special: 25}, CatchRate: 120, GrowthRate: MediumFast},
+	{Dex: 12, Index: 0x7D, Name: "BUTTERFREE", Types: [2]Type{Bug, Flying}, BaseStats: BaseStats{HP: 60, Attack: 45, Defense: 50, Speed: 70, Special: 80}, CatchRate: 45, GrowthRate: MediumFast},
+	{Dex: 13, Index: 0x70, Name: "WEEDLE", Types: [2]Type{Bug, Poison}, BaseStats: BaseStats{HP: 40, Attack: 35, Defense: 30, Speed: 50, Special: 20}, CatchRate: 255, GrowthRate: MediumFast},
+	{Dex: 14, Index: 0x71, Name: "KAKUNA", Types: [2]Type{Bug, Poison}, BaseStats: BaseStats{HP: 45, Attack: 25, Defense: 50, Speed: 35, Special: 25}, CatchRate: 120, GrowthRate: MediumFast},
+	{Dex: 15, Index: 0x72, Name: "BEEDRILL", Types: [2]Type{Bug, Poison}, BaseStats: BaseStats{HP: 65, Attack: 80, Defense: 40, Speed: 75, Special: 45}, CatchRate: 45, GrowthRate: MediumFast},
+	{Dex: 16, Index: 0x24, Name: "PIDGEY", Types: [2]Type{Normal, Flying}, BaseStats: BaseStats{HP: 40, Attack: 45, Defense: 40, Speed: 56, Special: 35}, CatchRate: 255, GrowthRate: MediumSlow},
+	{Dex: 17, Index: 0x96, Name: "PIDGEOTTO", Types: [2]Type{Normal, Flying}, BaseStats: BaseStats{HP: 63, Attack: 60, Defense: 55, Speed: 71, Special: 50}, CatchRate: 120, GrowthRate: MediumSlow},
+	{Dex: 18, Index: 0x97, Name: "PIDGEOT", Types: [2]Type{Normal, Flying}, BaseStats: BaseStats{HP: 83, Attack: 80, Defense: 75, Speed: 91, Special: 70}, CatchRate: 45, GrowthRate: MediumSlow},
+	{Dex: 19, Index: 0xA5, Name: "RATTATA", Types: [2]Type{Normal, Normal}, BaseStats: BaseStats{HP: 30, Attack: 56, Defense: 35, Speed: 72, Special: 25}, CatchRate: 255, GrowthRate: MediumFast},
+	{Dex: 20, Index: 0xA6, Name: "RATICATE", Types: [2]Type{Normal, Normal}, BaseStats: BaseStats{HP: 55, Attack: 81, Defense: 60, Speed: 97, Special: 50}, CatchRate: 90, GrowthRate: MediumFast},
+	{Dex: 21, Index: 0x05, Name: "SPEAROW", Types: [2]Type{Normal, Flying}, BaseStats: BaseStats{HP: 40, Attack: 60, Defense: 30, Speed: 70, Special: 31}, CatchRate: 255, GrowthRate: MediumFast},
+	{Dex: 22, Index: 0x23, Name: "FEAROW", Types: [2]Type{Normal, Flying}, BaseStats: BaseStats{HP: 65, Attack: 90, Defense: 65, Speed: 100, Special: 61}, CatchRate: 90, GrowthRate: MediumFast},
+	{Dex: 23, Index: 0x6C, Name: "EKANS", Types: [2]Type{Poison, Poison}, BaseStats: BaseStats{HP: 35, Attack: 60, Defense: 44, Speed: 55, Special: 40}, CatchRate: 255, GrowthRate: MediumFast},
+	{Dex: 24, Index: 0x2D, Name: "ARBOK", Types: [2]Type{Poison, Poison}, BaseStats: BaseStats{HP: 60, Attack: 85, Defense: 69, Speed: 80, Special: 65}, CatchRate: 90, GrowthRate: MediumFast},
+	{Dex: 25, Index: 0x54, Name: "PIKACHU", Types: [2]Type{Electric, Electric}, BaseStats: BaseStats{HP: 35, Attack: 55, Defense: 30, Speed: 90, Special: 50}, CatchRate: 190, GrowthRate: MediumFast},
+	{Dex: 26, Index: 0x55, Name: "RAICHU", Types: [2]Type{Electric, Electric}, BaseStats: BaseStats{HP: 60, Attack: 90, Defense: 55, Speed: 100, Special: 90}, CatchRate: 75, GrowthRate: MediumFast},
+	{Dex: 27, Index: 0x60, Name: "SANDSHREW", Types: [2]Type{Ground, Ground}, BaseStats: BaseStats{HP: 50, Attack: 75, Defense: 85, Speed: 40, Special: 30}, CatchRate: 255, GrowthRate: MediumFast},
+	{Dex: 28, Index: 0x61, Name: "SANDSLASH", Types: [2]Type{Ground, Ground}, BaseStats: BaseStats{HP: 75, Attack: 100, Defense: 110, Speed: 65, Special: 55}, CatchRate: 90, GrowthRate: MediumFast},
+	{Dex: 29, Index: 0x0F, Name: "NIDORAN♀", Types: [2]Type{Poison, Poison}, BaseStats: BaseStats{HP: 55, Attack: 47, Defense: 52, Speed: 41, Special: 40}, CatchRate: 235, GrowthRate: MediumSlow},
+	{Dex: 30, Index: 0xA8, Name: "NIDORINA", Types: [2]Type{Poison, Poison}, BaseStats: BaseStats{HP: 70, Attack: 62, Defense: 67, Speed: 56, Special: 55}, CatchRate: 120, GrowthRate: MediumSlow},
+	{Dex: 31, Index: 0x10, Name: "NIDOQUEEN", Types: [2]Type{Poison, Ground}, BaseStats: BaseStats{HP: 90, Attack: 82, Defense: 87, Speed: 76, Special: 75}, CatchRate: 45, GrowthRate: MediumSlow},
+	{Dex: 32, Index: 0x03, Name: "NIDORAN♂", Types: [2]Type{Poison, Poison}, BaseStats: BaseStats{HP: 46, Attack: 57, Defense: 40, Speed: 50, Special: 40}, CatchRate: 235, GrowthRate: MediumSlow},
+	{Dex: 33, Index: 0xA7, Name: "NIDORINO", Types: [2]Type{Poison, Poison}, BaseStats: BaseStats{HP: 61, Attack: 72, Defense: 57, Speed: 65, Special: 55}, CatchRate: 120, GrowthRate: MediumSlow},
+	{Dex: 34, Index: 0x07, Name: "NIDOKING", Types: [2]Type{Poison, Ground}, BaseStats: BaseStats{HP: 81, Attack: 92, Defense: 77, Speed: 85, Special: 75}, CatchRate: 45, GrowthRate: MediumSlow},
+	{Dex: 35, Index: 0x04, Name: "CLEFAIRY", Types: [2]Type{Normal, Normal}, BaseStats: BaseStats{HP: 70, Attack: 45, Defense: 48, Speed: 35, Special: 60}, CatchRate: 150, GrowthRate: Fast},
+	{Dex: 36, Index: 0x8E, Name: "CLEFABLE", Types: [2]Type{Normal, Normal}, BaseStats: BaseStats{HP: 95, Attack: 70, Defense: 73, Speed: 60, Special: 85}, CatchRate: 25, GrowthRate: Fast},
+	{Dex: 37, Index: 0x52, Name: "VULPIX", Types: [2]Type{Fire, Fire}, BaseStats: BaseStats{HP: 38, Attack: 41, Defense: 40, Speed: 65, Special: 65}, CatchRate: 190, GrowthRate: MediumFast},
+	{Dex: 38, Index: 0x53, Name: "NINETALES", Types: [2]Type{Fire, Fire}, BaseStats: BaseStats{HP: 73, Attack: 76, Defense: 75, Speed: 100, Special: 100}, CatchRate: 75, GrowthRate: MediumFast},
+	{Dex: 39, Index: 0x64, Name: "JIGGLYPUFF", Types: [2]Type{Normal, Normal}, BaseStats: BaseStats{HP: 115, Attack: 45, Defense: 20, Speed: 20, Special: 25}, CatchRate: 170, GrowthRate: Fast},
+	{Dex: 40, Index: 0x65, Name: "WIGGLYTUFF", Types: [2]Type{Normal, Normal}, BaseStats: BaseStats{HP: 140, Attack: 70, Defense: 45, Speed: 45, Special: 50}, CatchRate: 50, GrowthRate: Fast},
+	{Dex: 41, Index: 0x6B, Name: "ZUBAT", Types: [2]Type{Poison, Flying}, BaseStats: BaseStats{HP: 40, Attack: 45, Defense: 35, Speed: 55, Special: 40}, CatchRate: 255, GrowthRate: MediumFast},
+	{Dex: 42, Index: 0x82, Name: "GOLBAT", Types: [2]Type{Poison, Flying}, BaseStats: BaseStats{HP: 75, Attack: 80, Defense: 70, Speed: 90, Special: 75}, CatchRate: 90, GrowthRate: MediumFast},
+	{Dex: 43, Index: 0xB9, Name: "ODDISH", Types: [2]Type{Grass, Poison}, BaseStats: BaseStats{HP: 45, Attack: 50, Defense: 55, Speed: 30, Special: 75}, CatchRate: 255, GrowthRate: MediumSlow},
+	{Dex: 44, Index: 0xBA, Name: "GLOOM", Types: [2]Type{Grass, Poison}, BaseStats: BaseStats{HP: 60, Attack: 65, Defense: 70, Speed: 40, Special: 85}, CatchRate: 120, GrowthRate: MediumSlow},
+	{Dex: 45, Index: 0xBB, Name: "VILEPLUME", Types: [2]Type{Grass, Poison}, BaseStats: BaseStats{HP: 75, Attack: 80, Defense: 85, Speed: 50, Special: 100}, CatchRate: 45, GrowthRate: MediumSlow},
+	{Dex: 46, Index: 0x6D, Name: "PARAS", Types: [2]Type{Bug, Grass}, BaseStats: BaseStats{HP: 35, Attack: 70, Defense: 55, Speed: 25, Special: 55}, CatchRate: 190, GrowthRate: MediumFast},
+	{Dex: 47, Index: 0x2E, Name: "PARASECT", Types: [2]Type{Bug, Grass}, BaseStats: BaseStats{HP: 60, Attack: 95, Defense: 80, Speed: 30, Special: 80}, CatchRate: 75, GrowthRate: MediumFast},
+	{Dex: 48, Index: 0x41, Name: "VENONAT", Types: [2]Type{Bug, Poison}, BaseStats: BaseStats{HP: 60, Attack: 55, Defense: 50, Speed: 45, Special: 40}, CatchRate: 190, GrowthRate: MediumFast},
+	{Dex: 49, Index: 0x77, Name: "VENOMOTH", Types: [2]Type{Bug, Poison}, BaseStats: BaseStats{HP: 70, Attack: 65, Defense: 60, Speed: 90, Special: 90}, CatchRate: 75, GrowthRate: MediumFast},
+	{Dex: 50, Index: 0x3B, Name: "DIGLETT", Types: [2]Type{Ground, Ground}, BaseStats: BaseStats{HP: 10, Attack: 55, Defense: 25, Speed: 95, Special: 45}, CatchRate: 255, GrowthRate: MediumFast},
+	{Dex: 51, Index: 0x76, Name: "DUGTRIO", Types: [2]Type{Ground, Ground}, BaseStats: BaseStats{HP: 35, Attack: 80, Defense: 50, Speed: 120, Special: 70}, CatchRate: 50, GrowthRate: MediumFast},
+	{Dex: 52, Index: 0x4D, Name: "MEOWTH", Types: [2]Type{Normal, Normal}, BaseStats: BaseStats{HP: 40, Attack: 45, Defense: 35, Speed: 90, Special: 40}, CatchRate: 255, GrowthRate: MediumFast},
+	{Dex: 53, Index: 0x90, Name: "PERSIAN", Types: [2]Type{Normal, Normal}, BaseStats: BaseStats{HP: 65, Attack: 70, Defense: 60, Speed: 115, Special: 65}, CatchRate: 90, GrowthRate: MediumFast},
+	{Dex: 54, Index: 0x2F, Name: "PSYDUCK", Types: [2]Type{Water, Water}, BaseStats: BaseStats{HP: 50, Attack: 52, Defense: 48, Speed: 55, Special: 50}, CatchRate: 190, GrowthRate: MediumFast},
+	{Dex: 55, Index: 0x80, Name: "GOLDUCK", Types: [2]Type{Water, Water}, BaseStats: BaseStats{HP: 80, Attack: 82, Defense: 78, Speed: 85, Special: 80}, CatchRate: 75, GrowthRate: MediumFast},
+	{Dex: 56, Index: 0x39, Name: "MANKEY", Types: [2]Type{Fighting, Fighting}, BaseStats: BaseStats{HP: 40, Attack: 80, Defense: 35, Speed: 70, Special: 35}, CatchRate: 190, GrowthRate: MediumFast},
+	{Dex: 57, Index: 0x75, Name: "PRIMEAPE", Types: [2]Type{Fighting, Fighting}, BaseStats: BaseStats{HP: 65, Attack: 105, Defense: 60, Speed: 95, Special: 60}, CatchRate: 75, GrowthRate: MediumFast},
+	{Dex: 58, Index: 0x21, Name: "GROWLITHE", Types: [2]Type{Fire, Fire}, BaseStats: BaseStats{HP: 55, Attack: 70, Defense: 45, Speed: 60, Special: 50}, CatchRate: 190, GrowthRate: Slow},
+	{Dex: 59, Index: 0x14, Name: "ARCANINE", Types: [2]Type{Fire, Fire}, BaseStats: BaseStats{HP: 90, Attack: 110, Defense: 80, Speed: 95, Special: 80}, CatchRate: 75, GrowthRate: Slow},
+	{Dex: 60, Index: 0x47, Name: "POLIWAG", Types: [2]Type{Water, Water}, BaseStats: BaseStats{HP: 40, Attack: 50, Defense: 40, Speed: 90, Special: 40}, CatchRate: 255, GrowthRate: MediumSlow},
+	{Dex: 61, Index: 0x6E, Name: "POLIWHIRL", Types: [2]Type{Water, Water}, BaseStats: BaseStats{HP: 65, Attack: 65, Defense: 65, Speed: 90, Special: 50}, CatchRate: 120, GrowthRate: MediumSlow},
+	{Dex: 62, Index: 0x6F, Name: "POLIWRATH", Types: [2]Type{Water, Fighting}, BaseStats: BaseStats{HP: 90, Attack: 85, Defense: 95, Speed: 70, Special: 70}, CatchRate: 45, GrowthRate: MediumSlow},
+	{Dex: 63, Index: 0x94, Name: "ABRA", Types: [2]Type{Psychic, Psychic}, BaseStats: BaseStats{HP: 25, Attack: 20, Defense: 15, Speed: 90, Special: 105}, CatchRate: 200, GrowthRate: MediumSlow},
+	{Dex: 64, Index: 0x26, Name: "KADABRA", Types: [2]Type{Psychic, Psychic}, BaseStats: BaseStats{HP: 40, Attack: 35, Defense: 30, Speed: 105, Special: 120}, CatchRate: 100, GrowthRate: MediumSlow},
+	{Dex: 65, Index: 0x95, Name: "ALAKAZAM", Types: [2]Type{Psychic, Psychic}, BaseStats: BaseStats{HP: 55, Attack: 50, Defense: 45, Speed: 120, Special: 135}, CatchRate: 50, GrowthRate: MediumSlow},
+	{Dex: 66, Index: 0x6A, Name: "MACHOP", Types: [2]Type{Fighting, Fighting}, BaseStats: BaseStats{HP: 70, Attack: 80, Defense: 50, Speed: 35, Special: 35}, CatchRate: 180, GrowthRate: MediumSlow},
+	{Dex: 67, Index: 0x29, Name: "MACHOKE", Types: [2]Type{Fighting, Fighting}, BaseStats: BaseStats{HP: 80, Attack: 100, Defense: 70, Speed: 45, Special: 50}, CatchRate: 90, GrowthRate: MediumSlow},
+	{Dex: 68, Index: 0x7E, Name: "MACHAMP", Types: [2]Type{Fighting, Fighting}, BaseStats: BaseStats{HP: 90, Attack: 130, Defense: 80, Speed: 55, Special: 65}, CatchRate: 45, GrowthRate: MediumSlow},
+	{Dex: 69, Index: 0xBC, Name: "BELLSPROUT", Types: [2]Type{Grass, Poison}, BaseStats: BaseStats{HP: 50, Attack: 75, Defense: 35, Speed: 40, Special: 70}, CatchRate: 255, GrowthRate: MediumSlow},
+	{Dex: 70, Index: 0xBD, Name: "WEEPINBELL", Types: [2]Type{Grass, Poison}, BaseStats: BaseStats{HP: 65, Attack: 90, Defense: 50, Speed: 55, Special: 85}, CatchRate: 120, GrowthRate: MediumSlow},
+	{Dex: 71, Index: 0xBE, Name: "VICTREEBEL", Types: [2]Type{Grass, Poison}, BaseStats: BaseStats{HP: 80, Attack: 105, Defense: 65, Speed: 70, Special: 100}, CatchRate: 45, GrowthRate: MediumSlow},
+	{Dex: 72, Index: 0x18, Name: "TENTACOOL", Types: [2]Type{Water, Poison}, BaseStats: BaseStats{HP: 40, Attack: 40, Defense: 35, Speed: 70, Special: 100}, CatchRate: 190, GrowthRate: Slow},
+	{Dex: 73, Index: 0x9B, Name: "TENTACRUEL", Types: [2]Type{Water, Poison}, BaseStats: BaseStats{HP: 80, Attack: 70, Defense: 65, Speed: 100, Special: 120}, CatchRate: 60, GrowthRate: Slow},
+	{Dex: 74, Index: 0xA9, Name: "GEODUDE", Types: [2]Type{Rock, Ground}, BaseStats: BaseStats{HP: 40, Attack: 80, Defense: 100, Speed: 20, Special: 30}, CatchRate: 255, GrowthRate: MediumSlow},
+	{Dex: 75, Index: 0x27, Name: "GRAVELER", Types: [2]Type{Rock, Ground}, BaseStats: BaseStats{HP: 55, Attack: 95, Defense: 115, Speed: 35, Special: 45}, CatchRate: 120, GrowthRate: MediumSlow},
+	{Dex: 76, Index: 0x31, Name: "GOLEM", Types: [2]Type{Rock, Ground}, BaseStats: BaseStats{HP: 80, Attack: 110, Defense: 130, Speed: 45, Special: 55}, CatchRate: 45, GrowthRate: MediumSlow},
+	{Dex: 77, Index: 0xA3, Name: "PONYTA", Types: [2]Type{Fire, Fire}, BaseStats: BaseStats{HP: 50, Attack: 85, Defense: 55, Speed: 90, Special: 65}, CatchRate: 190, GrowthRate: MediumFast},
+	{Dex: 78, Index: 0xA4, Name: "RAPIDASH", Types: [2]Type{Fire, Fire}, BaseStats: BaseStats{HP: 65, Attack: 100, Defense: 70, Speed: 105, Special: 80}, CatchRate: 60, GrowthRate: MediumFast},
+	{Dex: 79, Index: 0x25, Name: "SLOWPOKE", Types: [2]Type{Water, Psychic}, BaseStats: BaseStats{HP: 90, Attack: 65, Defense: 65, Speed: 15, Special: 40}, CatchRate: 190, GrowthRate: MediumFast},
+	{Dex: 80, Index: 0x08, Name: "SLOWBRO", Types: [2]Type{Water, Psychic}, BaseStats: BaseStats{HP: 95, Attack: 75, Defense: 110, Speed: 30, Special: 80}, CatchRate: 75, GrowthRate: MediumFast},
+	{Dex: 81, Index: 0xAD, Name: "MAGNEMITE", Types: [2]Type{Electric, Electric}, BaseStats: BaseStats{HP: 25, Attack: 35, Defense: 70, Speed: 45, Special: 95}, CatchRate: 190, GrowthRate: MediumFast},
+	{Dex: 82, Index: 0x36, Name: "MAGNETON", Types: [2]Type{Electric, Electric}, BaseStats: BaseStats{HP: 50, Attack: 60, Defense: 95, Speed: 70, Special: 120}, CatchRate: 60, GrowthRate: MediumFast},
+	{Dex: 83, Index: 0x40, Name: "FARFETCH'D", Types: [2]Type{Normal, Flying}, BaseStats: BaseStats{HP: 52, Attack: 65, Defense: 55, Speed: 60, Special: 58}, CatchRate: 45, GrowthRate: MediumFast},
+	{Dex: 84, Index: 0x46, Name: "DODUO", Types: [2]Type{Normal, Flying}, BaseStats: BaseStats{HP: 35, Attack: 85, Defense: 45, Speed: 75, Special: 35}, CatchRate: 190, GrowthRate: MediumFast},
+	{Dex: 85, Index: 0x74, Name: "DODRIO", Types: [2]Type{Normal, Flying}, BaseStats: BaseStats{HP: 60, Attack: 110, Defense: 70, Speed: 100, Special: 60}, CatchRate: 45, GrowthRate: MediumFast},
+	{Dex: 86, Index: 0x3A, Name: "SEEL", Types: [2]Type{Water, Water}, BaseStats: BaseStats{HP: 65, Attack: 45, Defense: 55, Speed: 45, Special: 70}, CatchRate: 190, GrowthRate: MediumFast},
+	{Dex: 87, Index: 0x78, Name: "DEWGONG", Types: [2]Type{Water, Ice}, BaseStats: BaseStats{HP: 90, Attack: 70, Defense: 80, Speed: 70, Special: 95}, CatchRate: 75, GrowthRate: MediumFast},
+	{Dex: 88, Index: 0x0D, Name: "GRIMER", Types: [2]Type{Poison, Poison}, BaseStats: BaseStats{HP: 80, Attack: 80, Defense: 50, Speed: 25, Special: 40}, CatchRate: 190, GrowthRate: MediumFast},
+	{Dex: 89, Index: 0x88, Name: "MUK", Types: [2]Type{Poison, Poison}, BaseStats: BaseStats{HP: 105, Attack: 105, Defense: 75, Speed: 50, Special: 65}, CatchRate: 75, GrowthRate: MediumFast},
+	{Dex: 90, Index: 0x17, Name: "SHELLDER", Types: [2]Type{Water, Water}, BaseStats: BaseStats{HP: 30, Attack: 65, Defense: 100, Speed: 40, Special: 45}, CatchRate: 190, GrowthRate: Slow},
+	{Dex: 91, Index: 0x8B, Name: "CLOYSTER", Types: [2]Type{Water, Ice}, BaseStats: BaseStats{HP: 50, Attack: 95, Defense: 180, Speed: 70, Special: 85}, CatchRate: 60, GrowthRate: Slow},
+	{Dex: 92, Index: 0x19, Name: "GASTLY", Types: [2]Type{Ghost, Poison}, BaseStats: BaseStats{HP: 30, Attack: 35, Defense: 30, Speed: 80, Special: 100}, CatchRate: 190, GrowthRate: MediumSlow},
+	{Dex: 93, Index: 0x93, Name: "HAUNTER", Types: [2]Type{Ghost, Poison}, BaseStats: BaseStats{HP: 45, Attack: 50, Defense: 45, Speed: 95, Special: 115}, CatchRate: 90, GrowthRate: MediumSlow},
+	{Dex: 94, Index: 0x0E, Name: "GENGAR", Types: [2]Type{Ghost, Poison}, BaseStats: BaseStats{HP: 60, Attack: 65, Defense: 60, Speed: 110, Special: 130}, CatchRate: 45, GrowthRate: MediumSlow},
+	{Dex: 95, Index: 0x22, Name: "ONIX", Types: [2]Type{Rock, Ground}, BaseStats: BaseStats{HP: 35, Attack: 45, Defense: 160, Speed: 70, Special: 30}, CatchRate: 45, GrowthRate: MediumFast},
+	{Dex: 96, Index: 0x30, Name: "DROWZEE", Types: [2]Type{Psychic, Psychic}, BaseStats: BaseStats{HP: 60, Attack: 48, Defense: 45, Speed: 42, Special: 90}, CatchRate: 190, GrowthRate: MediumFast},
+	{Dex: 97, Index: 0x81, Name: "HYPNO", Types: [2]Type{Psychic, Psychic}, BaseStats: BaseStats{HP: 85, Attack: 73, Defense: 70, Speed: 67, Special: 115}, CatchRate: 75, GrowthRate: MediumFast},
+	{Dex: 98, Index: 0x4E, Name: "KRABBY", Types: [2]Type{Water, Water}, BaseStats: BaseStats{HP: 30, Attack: 105, Defense: 90, Speed: 50, Special: 25}, CatchRate: 225, GrowthRate: MediumFast},
+	{Dex: 99, Index: 0x8A, Name: "KINGLER", Types: [2]Type{Water, Water}, BaseStats: BaseStats{HP: 55, Attack: 130, Defense: 115, Speed: 75, Special: 50}, CatchRate: 60, GrowthRate: MediumFast},
+	{Dex: 100, Index: 0x06, Name: "VOLTORB", Types: [2]Type{Electric, Electric}, BaseStats: BaseStats{HP: 40, Attack: 30, Defense: 50, Speed: 100, Special: 55}, CatchRate: 190, GrowthRate: MediumFast},
+	{Dex: 101, Index: 0x8D, Name: "ELECTRODE", Types: [2]Type{Electric, Electric}, BaseStats: BaseStats{HP: 60, Attack: 50, Defense: 70, Speed: 140, Special: 80}, CatchRate: 60, GrowthRate: MediumFast},
+	{Dex: 102, Index: 0x0C, Name: "EXEGGCUTE", Types: [2]Type{Grass, Psychic}, BaseStats: BaseStats{HP: 60, Attack: 40, Defense: 80, Speed: 40, Special: 60}, CatchRate: 90, GrowthRate: Slow},
+	{Dex: 103, Index: 0x0A, Name: "EXEGGUTOR", Types: [2]Type{Grass, Psychic}, BaseStats: BaseStats{HP: 95, Attack: 95, Defense: 85, Speed: 55, Special: 125}, CatchRate: 45, GrowthRate: Slow},
+	{Dex: 104, Index: 0x11, Name: "CUBONE", Types: [2]Type{Ground, Ground}, BaseStats: BaseStats{HP: 50, Attack: 50, Defense: 95, Speed: 35, Special: 40}, CatchRate: 190, GrowthRate: MediumFast},
+	{Dex: 105, Index: 0x91, Name: "MAROWAK", Types: [2]Type{Ground, Ground}, BaseStats: BaseStats{HP: 60, Attack: 80, Defense: 110, Speed: 45, Special: 50}, CatchRate: 75, GrowthRate: MediumFast},
+	{Dex: 106, Index: 0x2B, Name: "HITMONLEE", Types: [2]Type{Fighting, Fighting}, BaseStats: BaseStats{HP: 50, Attack: 120, Defense: 53, Speed: 87, Special: 35}, CatchRate: 45, GrowthRate: MediumFast},
+	{Dex: 107, Index: 0x2C, Name: "HITMONCHAN", Types: [2]Type{Fighting, Fighting}, BaseStats: BaseStats{HP: 50, Attack: 105, Defense: 79, Speed: 76, Special: 35}, CatchRate: 45, GrowthRate: MediumFast},
+	{Dex: 108, Index: 0x0B, Name: "LICKITUNG", Types: [2]Type{Normal, Normal}, BaseStats: BaseStats{HP: 90, Attack: 55, Defense: 75, Speed: 30, Special: 60}, CatchRate: 45, GrowthRate: MediumFast},
+	{Dex: 109, Index: 0x37, Name: "KOFFING", Types: [2]Type{Poison, Poison}, BaseStats: BaseStats{HP: 40, Attack: 65, Defense: 95, Speed: 35, Special: 60}, CatchRate: 190, GrowthRate: MediumFast},
+	{Dex: 110, Index: 0x8F, Name: "WEEZING", Types: [2]Type{Poison, Poison}, BaseStats: BaseStats{HP: 65, Attack: 90, Defense: 120, Speed: 60, Special: 85}, CatchRate: 60, GrowthRate: MediumFast},
+	{Dex: 111, Index: 0x12, Name: "RHYHORN", Types: [2]Type{Ground, Rock}, BaseStats: BaseStats{HP: 80, Attack: 85, Defense: 95, Speed: 25, Special: 30}, CatchRate: 120, GrowthRate: Slow},
+	{Dex: 112, Index: 0x01, Name: "RHYDON", Types: [2]Type{Ground, Rock}, BaseStats: BaseStats{HP: 105, Attack: 130, Defense: 120, Speed: 40, Special: 45}, CatchRate: 60, GrowthRate: Slow},
+	{Dex: 113, Index: 0x28, Name: "CHANSEY", Types: [2]Type{Normal, Normal}, BaseStats: BaseStats{HP: 250, Attack: 5, Defense: 5, Speed: 50, Special: 105}, CatchRate: 30, GrowthRate: Fast},
+	{Dex: 114, Index: 0x1E, Name: "TANGELA", Types: [2]Type{Grass, Grass}, BaseStats: BaseStats{HP: 65, Attack: 55, Defense: 115, Speed: 60, Special: 100}, CatchRate: 45, GrowthRate: MediumFast},
+	{Dex: 115, Index: 0x02, Name: "KANGASKHAN", Types: [2]Type{Normal, Normal}, BaseStats: BaseStats{HP: 105, Attack: 95, Defense: 80, Speed: 90, Special: 40}, CatchRate: 45, GrowthRate: MediumFast},
+	{Dex: 116, Index: 0x5C, Name: "HORSEA", Types: [2]Type{Water, Water}, BaseStats: BaseStats{HP: 30, Attack: 40, Defense: 70, Speed: 60, Special: 70}, CatchRate: 225, GrowthRate: MediumFast},
+	{Dex: 117, Index: 0x5D, Name: "SEADRA", Types: [2]Type{Water, Water}, BaseStats: BaseStats{HP: 55, Attack: 65, Defense: 95, Speed: 85, Special: 95}, CatchRate: 75, GrowthRate: MediumFast},
+	{Dex: 118, Index: 0x9D, Name: "GOLDEEN", Types: [2]Type{Water, Water}, BaseStats: BaseStats{HP: 45, Attack: 67, Defense: 60, Speed: 63, Special: 50}, CatchRate: 225, GrowthRate: MediumFast},
+	{Dex: 119, Index: 0x9E, Name: "SEAKING", Types: [2]Type{Water, Water}, BaseStats: BaseStats{HP: 80, Attack: 92, Defense: 65, Speed: 68, Special: 80}, CatchRate: 60, GrowthRate: MediumFast},
+	{Dex: 120, Index: 0x1B, Name: "STARYU", Types: [2]Type{Water, Water}, BaseStats: BaseStats{HP: 30, Attack: 45, Defense: 55, Speed: 85, Special: 70}, CatchRate: 225, GrowthRate: Slow},
+	{Dex: 121, Index: 0x98, Name: "STARMIE", Types: [2]Type{Water, Psychic}, BaseStats: BaseStats{HP: 60, Attack: 75, Defense: 85, Speed: 115, Special: 100}, CatchRate: 60, GrowthRate: Slow},
+	{Dex: 122, Index: 0x2A, Name: "MR.MIME", Types: [2]Type{Psychic, Psychic}, BaseStats: BaseStats{HP: 40, Attack: 45, Defense: 65, Speed: 90, Special: 100}, CatchRate: 45, GrowthRate: MediumFast},
+	{Dex: 123, Index: 0x1A, Name: "SCYTHER", Types: [2]Type{Bug, Flying}, BaseStats: BaseStats{HP: 70, Attack: 110, Defense: 80, Speed: 105, Special: 55}, CatchRate: 45, GrowthRate: MediumFast},
+	{Dex: 124, Index: 0x48, Name: "JYNX", Types: [2]Type{Ice, Psychic}, BaseStats: BaseStats{HP: 65, Attack: 50, Defense: 35, Speed: 95, Special: 95}, CatchRate: 45, GrowthRate: MediumFast},
+	{Dex: 125, Index: 0x35, Name: "ELECTABUZZ", Types: [2]Type{Electric, Electric}, BaseStats: BaseStats{HP: 65, Attack: 83, Defense: 57, Speed: 105, Special: 85}, CatchRate: 45, GrowthRate: MediumFast},
+	{Dex: 126, Index: 0x33, Name: "MAGMAR", Types: [2]Type{Fire, Fire}, BaseStats: BaseStats{HP: 65, Attack: 95, Defense: 57, Speed: 93, Special: 85}, CatchRate: 45, GrowthRate: MediumFast},
+	{Dex: 127, Index: 0x1D, Name: "PINSIR", Types: [2]Type{Bug, Bug}, BaseStats: BaseStats{HP: 65, Attack: 125, Defense: 100, Speed: 85, Special: 55}, CatchRate: 45, GrowthRate: Slow},
+	{Dex: 128, Index: 0x3C, Name: "TAUROS", Types: [2]Type{Normal, Normal}, BaseStats: BaseStats{HP: 75, Attack: 100, Defense: 95, Speed: 110, Special: 70}, CatchRate: 45, GrowthRate: Slow},
+	{Dex: 129, Index: 0x85, Name: "MAGIKARP", Types: [2]Type{Water, Water}, BaseStats: BaseStats{HP: 20, Attack: 10, Defense: 55, Speed: 80, Special: 20}, CatchRate: 255, GrowthRate: Slow},
+	{Dex: 130, Index: 0x16, Name: "GYARADOS", Types: [2]Type{Water, Flying}, BaseStats: BaseStats{HP: 95, Attack: 125, Defense: 79, Speed: 81, Special: 100}, CatchRate: 45, GrowthRate: Slow},
+	{Dex: 131, Index: 0x13, Name: "LAPRAS", Types: [2]Type{Water, Ice}, BaseStats: BaseStats{HP: 130, Attack: 85, Defense: 80, Speed: 60, Special: 95}, CatchRate: 45, GrowthRate: Slow},
+	{Dex: 132, Index: 0x4C, Name: "DITTO", Types: [2]Type{Normal, Normal}, BaseStats: BaseStats{HP: 48, Attack: 48, Defense: 48, Speed: 48, Special: 48}, CatchRate: 35, GrowthRate: MediumFast},
+	{Dex: 133, Index: 0x66, Name: "EEVEE", Types: [2]Type{Normal, Normal}, BaseStats: BaseStats{HP: 55, Attack: 55, Defense: 50, Speed: 55, Special: 65}, CatchRate: 45, GrowthRate: MediumFast},
+	{Dex: 134, Index: 0x69, Name: "VAPOREON", Types: [2]Type{Water, Water}, BaseStats: BaseStats{HP: 130, Attack: 65, Defense: 60, Speed: 65, Special: 110}, CatchRate: 45, GrowthRate: MediumFast},
+	{Dex: 135, Index: 0x68, Name: "JOLTEON", Types: [2]Type{Electric, Electric}, BaseStats: BaseStats{HP: 65, Attack: 65, Defense: 60, Speed: 130, Special: 110}, CatchRate: 45, GrowthRate: MediumFast},
+	{Dex: 136, Index: 0x67, Name: "FLAREON", Types: [2]Type{Fire, Fire}, BaseStats: BaseStats{HP: 65, Attack: 130, Defense: 60, Speed: 65, Special: 110}, CatchRate: 45, GrowthRate: MediumFast},
+	{Dex: 137, Index: 0xAA, Name: "PORYGON", Types: [2]Type{Normal, Normal}, BaseStats: BaseStats{HP: 65, Attack: 60, Defense: 70, Speed: 40, Special: 75}, CatchRate: 45, GrowthRate: MediumFast},
+	{Dex: 138, Index: 0x62, Name: "OMANYTE", Types: [2]Type{Rock, Water}, BaseStats: BaseStats{HP: 35, Attack: 40, Defense: 100, Speed: 35, Special: 90}, CatchRate: 45, GrowthRate: MediumFast},
+	{Dex: 139, Index: 0x63, Name: "OMASTAR", Types: [2]Type{Rock, Water}, BaseStats: BaseStats{HP: 70, Attack: 60, Defense: 125, Speed: 55, Special: 115}, CatchRate: 45, GrowthRate: MediumFast},
+	{Dex: 140, Index: 0x5A, Name: "KABUTO", Types: [2]Type{Rock, Water}, BaseStats: BaseStats{HP: 30, Attack: 80, Defense: 90, Speed: 55, Special: 45}, CatchRate: 45, GrowthRate: MediumFast},
+	{Dex: 141, Index: 0x5B, Name: "KABUTOPS", Types: [2]Type{Rock, Water}, BaseStats: BaseStats{HP: 60, Attack: 115, Defense: 105, Speed: 80, Special: 70}, CatchRate: 45, GrowthRate: MediumFast},
+	{Dex: 142, Index: 0xAB, Name: "AERODACTYL", Types: [2]Type{Rock, Flying}, BaseStats: BaseStats{HP: 80, Attack: 105, Defense: 65, Speed: 130, Special: 60}, CatchRate: 45, GrowthRate: Slow},
+	{Dex: 143, Index: 0x84, Name: "SNORLAX", Types: [2]Type{Normal, Normal}, BaseStats: BaseStats{HP: 160, Attack: 110, Defense: 65, Speed: 30, Special: 65}, CatchRate: 25, GrowthRate: Slow},
+	{Dex: 144, Index: 0x4A, Name: "ARTICUNO", Types: [2]Type{Ice, Flying}, BaseStats: BaseStats{HP: 90, Attack: 85, Defense: 100, Speed: 85, Special: 125}, CatchRate: 3, GrowthRate: Slow},
+	{Dex: 145, Index: 0x4B, Name: "ZAPDOS", Types: [2]Type{Electric, Flying}, BaseStats: BaseStats{HP: 90, Attack: 90, Defense: 85, Speed: 100, Special: 125}, CatchRate: 3, GrowthRate: Slow},
+	{Dex: 146, Index: 0x49, Name: "MOLTRES", Types: [2]Type{Fire, Flying}, BaseStats: BaseStats{HP: 90, Attack: 100, Defense: 90, Speed: 90, Special: 125}, CatchRate: 3, GrowthRate: Slow},
+	{Dex: 147, Index: 0x58, Name: "DRATINI", Types: [2]Type{Dragon, Dragon}, BaseStats: BaseStats{HP: 41, Attack: 64, Defense: 45, Speed: 50, Special: 50}, CatchRate: 45, GrowthRate: Slow},
+	{Dex: 148, Index: 0x59, Name: "DRAGONAIR", Types: [2]Type{Dragon, Dragon}, BaseStats: BaseStats{HP: 61, Attack: 84, Defense: 65, Speed: 70, Special: 70}, CatchRate: 45, GrowthRate: Slow},
+	{Dex: 149, Index: 0x42, Name: "DRAGONITE", Types: [2]Type{Dragon, Flying}, BaseStats: BaseStats{HP: 91, Attack: 134, Defense: 95, Speed: 80, Special: 100}, CatchRate: 45, GrowthRate: Slow},
+	{Dex: 150, Index: 0x83, Name: "MEWTWO", Types: [2]Type{Psychic, Psychic}, BaseStats: BaseStats{HP: 106, Attack: 110, Defense: 90, Speed: 130, Special: 154}, CatchRate: 3, GrowthRate: Slow},
+	{Dex: 151, Index: 0x15, Name: "MEW", Types: [2]Type{Psychic, Psychic}, BaseStats: BaseStats{HP: 100, Attack: 100, Defense: 100, Speed: 100, Special: 100}, CatchRate: 45, GrowthRate: MediumSlow},
+}
+
+// SpeciesByIndex returns the species with the given internal index.
+func SpeciesByIndex(index byte) (Species, bool) {
+	for _, s := range species {
+		if s.Index == index {
+			return s, true
+		}
+	}
+	return Species{}, false
+}
+
+// SpeciesByDex returns the species with the given Pokédex number.
+func SpeciesByDex(dex int) (Species, bool) {
+	if dex < 1 || dex > len(species) {
+		return Species{}, false
+	}
+	return species[dex-1], true
+}
+
+// SpeciesByName returns the species with the given name, ignoring case, spaces and punctuation.
+// Nidoran may be written as NIDORAN♀ or Nidoran-F.
+func SpeciesByName(name string) (Species, bool) {
+	for _, s := range species {
+		if NormaliseName(s.Name) == NormaliseName(name) {
+			return s, true
+		}
+	}
+	return Species{}, false
+}
+
+// AllSpecies returns every species in Pokédex order.
+func AllSpecies() []Species {
+	return append([]Species(nil), species...)
+}
+
+// Legendary reports whether the species with the given Pokédex number is legendary:
+// the three legendary birds, Mewtwo and Mew.
+func Legendary(dex int) bool {
+	switch dex {
+	case 144, 145, 146, 150, 151:
+		return true
+	}
+	return false
+}
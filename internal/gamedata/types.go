@@ -0,0 +1,57 @@
+package gamedata
+
+// Type is a Pokémon or move type, as stored in save files.
+type Type byte
+
+const (
+	Normal   Type = 0x00
+	Fighting Type = 0x01
+	Flying   Type = 0x02
+	Poison   Type = 0x03
+	Ground   Type = 0x04
+	Rock     Type = 0x05
+	Bug      Type = 0x07
+	Ghost    Type = 0x08
+	Fire     Type = 0x14
+	Water    Type = 0x15
+	Grass    Type = 0x16
+	Electric Type = 0x17
+	Psychic  Type = 0x18
+	Ice      Type = 0x19
+	Dragon   Type = 0x1A
+)
+
+var typeNames = map[Type]string{
+	Normal:   "NORMAL",
+	Fighting: "FIGHTING",
+	Flying:   "FLYING",
+	Poison:   "POISON",
+	Ground:   "GROUND",
+	Rock:     "ROCK",
+	Bug:      "BUG",
+	Ghost:    "GHOST",
+	Fire:     "FIRE",
+	Water:    "WATER",
+	Grass:    "GRASS",
+	Electric: "ELECTRIC",
+	Psychic:  "PSYCHIC",
+	Ice:      "ICE",
+	Dragon:   "DRAGON",
+}
+
+func (t Type) String() string {
+	if name, ok := typeNames[t]; ok {
+		return name
+	}
+	return "UNKNOWN"
+}
+
+// TypeByName returns the type with the given name, ignoring case.
+func TypeByName(name string) (Type, bool) {
+	for t, n := range typeNames {
+		if NormaliseName(n) == NormaliseName(name) {
+			return t, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,193 @@
+// Package gifts holds the Pokémon given by the games' in-game trades and by distribution events,
+// with the nicknames, OT names and IDs they were given with.
+package gifts
+
+import (
+	"errors"
+	"fmt"
+	"pokegen/internal/gamedata"
+	"pokegen/internal/pokegen"
+	"pokegen/internal/util"
+)
+
+// ErrUnknownGift is returned when a gift is not in the library, or not given in the chosen game.
+var ErrUnknownGift = errors.New("unknown gift")
+
+// tradeOT is the OT name of Pokémon received from in-game trades:
+// the games' TRAINER control character alone, which displays as the word in the game's language.
+const tradeOT = string(util.Trainer)
+
+// Gift is a Pokémon in the library.
+type Gift struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Species     string `json:"species"`
+	// Gives is the species the player trades away for in-game trade Pokémon.
+	Gives    string `json:"gives,omitempty"`
+	Nickname string `json:"nickname,omitempty"`
+	// OTName of in-game trades is util.Trainer, the control character the games display as TRAINER,
+	// which util.DisplayText writes out when the name is shown.
+	OTName string `json:"ot_name"`
+	// OTID is left out for in-game trades, whose ID the game picks at random.
+	OTID *uint16 `json:"ot_id,omitempty"`
+	// Level is the level the gift is placed at unless another is asked for.
+	// In-game trade Pokémon take the level of the Pokémon traded for them,
+	// so default to the lowest level both it and they can be obtained at.
+	Level int            `json:"level"`
+	Games []pokegen.Game `json:"games"`
+
+	// trade is the number of an in-game trade in the game's trade table, which its done flag is numbered by,
+	// or -1 for event Pokémon.
+	trade int
+}
+
+var (
+	redBlue = []pokegen.Game{pokegen.GameRed, pokegen.GameBlue}
+	yellow  = []pokegen.Game{pokegen.GameYellow}
+	all     = []pokegen.Game{pokegen.GameRed, pokegen.GameBlue, pokegen.GameYellow}
+)
+
+func id(n uint16) *uint16 {
+	return &n
+}
+
+// library holds the in-game trades of each game, numbered as in its trade table, leaving out those the games never offer,
+// followed by the event Pokémon.
+var library = []Gift{
+	{Name: "terry", Description: "Nidorina traded for a Nidorino", Species: "Nidorina", Gives: "Nidorino", Nickname: "TERRY", Games: redBlue, trade: 0},
+	{Name: "marcel", Description: "Mr. Mime traded for an Abra", Species: "Mr. Mime", Gives: "Abra", Nickname: "MARCEL", Games: redBlue, trade: 1},
+	{Name: "sailor", Description: "Seel traded for a Ponyta", Species: "Seel", Gives: "Ponyta", Nickname: "SAILOR", Games: redBlue, trade: 3},
+	{Name: "dux", Description: "Farfetch'd traded for a Spearow", Species: "Farfetch'd", Gives: "Spearow", Nickname: "DUX", Games: redBlue, trade: 4},
+	{Name: "marc", Description: "Lickitung traded for a Slowbro", Species: "Lickitung", Gives: "Slowbro", Nickname: "MARC", Games: redBlue, trade: 5},
+	{Name: "lola", Description: "Jynx traded for a Poliwhirl", Species: "Jynx", Gives: "Poliwhirl", Nickname: "LOLA", Games: redBlue, trade: 6},
+	{Name: "doris", Description: "Electrode traded for a Raichu", Species: "Electrode", Gives: "Raichu", Nickname: "DORIS", Games: redBlue, trade: 7},
+	{Name: "crinkles", Description: "Tangela traded for a Venonat", Species: "Tangela", Gives: "Venonat", Nickname: "CRINKLES", Games: redBlue, trade: 8},
+	{Name: "spot", Description: "Nidoran♀ traded for a Nidoran♂", Species: "Nidoran♀", Gives: "Nidoran♂", Nickname: "SPOT", Games: redBlue, trade: 9},
+
+	{Name: "gurio", Description: "Dugtrio traded for a Lickitung", Species: "Dugtrio", Gives: "Lickitung", Nickname: "GURIO", Games: yellow, trade: 0},
+	{Name: "miles", Description: "Mr. Mime traded for a Clefairy", Species: "Mr. Mime", Gives: "Clefairy", Nickname: "MILES", Games: yellow, trade: 1},
+	{Name: "sticky", Description: "Muk traded for a Kangaskhan", Species: "Muk", Gives: "Kangaskhan", Nickname: "STICKY", Games: yellow, trade: 3},
+	{Name: "spike", Description: "Parasect traded for a Tangela", Species: "Parasect", Gives: "Tangela", Nickname: "SPIKE", Games: yellow, trade: 5},
+	{Name: "buffy", Description: "Rhydon traded for a Golduck", Species: "Rhydon", Gives: "Golduck", Nickname: "BUFFY", Games: yellow, trade: 7},
+	{Name: "cezanne", Description: "Dewgong traded for a Growlithe", Species: "Dewgong", Gives: "Growlithe", Nickname: "CEZANNE", Games: yellow, trade: 8},
+	{Name: "rick", Description: "Machoke traded for a Cubone", Species: "Machoke", Gives: "Cubone", Nickname: "RICK", Games: yellow, trade: 9},
+
+	{Name: "stadium-bulbasaur", Description: "Bulbasaur given by Pokémon Stadium", Species: "Bulbasaur", OTName: "STADIUM", OTID: id(2000), Level: 5, Games: all, trade: -1},
+	{Name: "stadium-charmander", Description: "Charmander given by Pokémon Stadium", Species: "Charmander", OTName: "STADIUM", OTID: id(2000), Level: 5, Games: all, trade: -1},
+	{Name: "stadium-squirtle", Description: "Squirtle given by Pokémon Stadium", Species: "Squirtle", OTName: "STADIUM", OTID: id(2000), Level: 5, Games: all, trade: -1},
+	{Name: "stadium-eevee", Description: "Eevee given by Pokémon Stadium", Species: "Eevee", OTName: "STADIUM", OTID: id(2000), Level: 25, Games: all, trade: -1},
+	{Name: "yoshira-mew", Description: "Mew given at North American events", Species: "Mew", OTName: "YOSHIRA", OTID: id(2510), Level: 5, Games: all, trade: -1},
+}
+
+// All returns every gift in the library, in-game trades first.
+func All() []Gift {
+	gifts := make([]Gift, len(library))
+	for i, g := range library {
+		gifts[i] = g.withDefaults()
+	}
+	return gifts
+}
+
+// ByName returns the gift with the given name, ignoring case and punctuation, that is given in the game.
+// In-game trades with the same name in every game, such as Red's and Blue's, share an entry.
+func ByName(name string, game pokegen.Game) (Gift, error) {
+	found := false
+	for _, g := range library {
+		if gamedata.NormaliseName(g.Name) != gamedata.NormaliseName(name) {
+			continue
+		}
+		found = true
+		for _, in := range g.Games {
+			if in == game {
+				return g.withDefaults(), nil
+			}
+		}
+	}
+	if found {
+		return Gift{}, fmt.Errorf("%w: %s is not given in %s", ErrUnknownGift, name, game)
+	}
+	return Gift{}, fmt.Errorf("%w: %s", ErrUnknownGift, name)
+}
+
+func (g Gift) withDefaults() Gift {
+	if g.trade >= 0 {
+		g.OTName = tradeOT
+		for _, name := range []string{g.Gives, g.Species} {
+			if s, ok := gamedata.SpeciesByName(name); ok && gamedata.MinimumLevel(s.Dex) > g.Level {
+				g.Level = gamedata.MinimumLevel(s.Dex)
+			}
+		}
+	}
+	if g.Level < 5 {
+		g.Level = 5
+	}
+	return g
+}
+
+// Pokemon returns the gift as a Pokémon to generate at the given level, or the gift's own level when 0,
+// knowing the moves a wild Pokémon of its species and level would.
+func (g Gift) Pokemon(level int) (pokegen.Pokemon, error) {
+	s, ok := gamedata.SpeciesByName(g.Species)
+	if !ok {
+		return pokegen.Pokemon{}, fmt.Errorf("%s: unknown species %q", g.Name, g.Species)
+	}
+	p := pokegen.Pokemon{
+		Species:  s.Index,
+		Level:    g.Level,
+		Nickname: g.Nickname,
+		OTName:   g.OTName,
+		OTID:     g.OTID,
+	}
+	if level != 0 {
+		p.Level = level
+	}
+	return p, nil
+}
+
+// Placement asks for a gift to be placed in a generated save.
+type Placement struct {
+	Name string `json:"name"`
+	// Level defaults to the gift's level.
+	Level int `json:"level,omitempty"`
+	// Box is the box, numbered from 1, to place the gift in; without it the gift joins the party.
+	Box int `json:"box,omitempty"`
+}
+
+// Place returns opts with each gift added to the end of the party or its box, in order,
+// and the in-game trades they came from marked as done.
+func Place(opts pokegen.Options, placements []Placement) (pokegen.Options, error) {
+	if len(placements) == 0 {
+		return opts, nil
+	}
+
+	party := append([]pokegen.Pokemon(nil), opts.Party...)
+	boxes := make(map[int][]pokegen.Pokemon, len(opts.Boxes))
+	for n, box := range opts.Boxes {
+		boxes[n] = box
+	}
+	trades := append([]int(nil), opts.InGameTrades...)
+
+	for i, placement := range placements {
+		g, err := ByName(placement.Name, opts.Game)
+		if err != nil {
+			return pokegen.Options{}, fmt.Errorf("gift %d: %w", i+1, err)
+		}
+		p, err := g.Pokemon(placement.Level)
+		if err != nil {
+			return pokegen.Options{}, fmt.Errorf("gift %d: %w", i+1, err)
+		}
+
+		if placement.Box == 0 {
+			party = append(party, p)
+		} else {
+			n := placement.Box - 1
+			boxes[n] = append(boxes[n][:len(boxes[n]):len(boxes[n])], p)
+		}
+		if g.trade >= 0 {
+			trades = append(trades, g.trade)
+		}
+	}
+
+	opts.Party, opts.Boxes, opts.InGameTrades = party, boxes, trades
+	return opts, nil
+}
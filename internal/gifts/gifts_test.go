@@ -0,0 +1,84 @@
+package gifts_test
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"pokegen/internal/gifts"
+	"pokegen/internal/pokegen"
+	"pokegen/internal/save"
+	"pokegen/internal/util"
+	"testing"
+)
+
+func TestPlace(t *testing.T) {
+	opts, err := gifts.Place(pokegen.Options{
+		Game:  pokegen.GameRed,
+		Party: []pokegen.Pokemon{{Species: 0x54, Level: 5}},
+	}, []gifts.Placement{
+		{Name: "LOLA", Level: 30},
+		{Name: "stadium-eevee", Box: 2},
+	})
+	assert.NoError(t, err)
+
+	assert.Len(t, opts.Party, 2)
+	lola := opts.Party[1]
+	assert.Equal(t, byte(0x48), lola.Species, "should be a jynx")
+	assert.Equal(t, 30, lola.Level)
+	assert.Equal(t, "LOLA", lola.Nickname)
+	assert.Equal(t, string(util.Trainer), lola.OTName)
+	assert.Equal(t, []int{6}, opts.InGameTrades)
+
+	eevee := opts.Boxes[1][0]
+	assert.Equal(t, 25, eevee.Level)
+	assert.Equal(t, "STADIUM", eevee.OTName)
+	assert.Equal(t, uint16(2000), *eevee.OTID)
+
+	buf := new(bytes.Buffer)
+	err = pokegen.Gen(buf, opts)
+	assert.NoError(t, err)
+	f, err := save.Load(buf.Bytes())
+	assert.NoError(t, err)
+	assert.True(t, f.InGameTradeDone(6))
+	assert.False(t, f.InGameTradeDone(1))
+
+	// The OT names follow the party's species list and Pokémon; LOLA's is second.
+	otNames := save.International.Party + 8 + 6*44
+	assert.Equal(t, []byte{0x5D, 0x50}, f.Bytes()[otNames+11:][:2], "lola's OT should be the TRAINER control character")
+
+	summary, err := f.Summary()
+	assert.NoError(t, err)
+	assert.Equal(t, "TRAINER", summary.Party[1].OTName, "the control character should be shown as TRAINER")
+}
+
+func TestPlace_Invalid(t *testing.T) {
+	for name, test := range map[string]struct {
+		game pokegen.Game
+		err  string
+	}{
+		"ditto": {pokegen.GameRed, "gift 1: unknown gift: ditto"},
+		"lola":  {pokegen.GameYellow, "gift 1: unknown gift: lola is not given in yellow"},
+	} {
+		_, err := gifts.Place(pokegen.Options{Game: test.game}, []gifts.Placement{{Name: name}})
+		assert.ErrorIs(t, err, gifts.ErrUnknownGift)
+		assert.EqualError(t, err, test.err)
+	}
+}
+
+func TestAll(t *testing.T) {
+	dewgong, _ := gifts.ByName("cezanne", pokegen.GameYellow)
+	assert.Equal(t, 34, dewgong.Level, "trades should default to a level the species can be obtained at")
+
+	for _, g := range gifts.All() {
+		for _, game := range g.Games {
+			opts, err := gifts.Place(pokegen.Options{Game: game}, []gifts.Placement{{Name: g.Name}})
+			assert.NoError(t, err, g.Name)
+
+			// Every gift should be a Pokémon the legality checker finds nothing impossible in.
+			findings, err := pokegen.CheckLegality(opts)
+			assert.NoError(t, err, g.Name)
+			for _, f := range findings {
+				assert.NotEqual(t, save.SeverityError, f.Severity, "%s in %s: %s", g.Name, game, f)
+			}
+		}
+	}
+}
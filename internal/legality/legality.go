@@ -0,0 +1,220 @@
+// Package legality checks whether Pokémon could have been obtained in the Gen 1 games.
+package legality
+
+import (
+	"fmt"
+	"pokegen/internal/gamedata"
+	"pokegen/internal/save"
+)
+
+// Finding is a reason a Pokémon could not have been obtained.
+// Errors are impossible in the version checked; warnings are only possible through means the checker does not know about.
+type Finding struct {
+	Slot     string        `json:"slot,omitempty"`
+	Species  string        `json:"species"`
+	Severity save.Severity `json:"severity"`
+	Message  string        `json:"message"`
+}
+
+func (f Finding) String() string {
+	if f.Slot == "" {
+		return fmt.Sprintf("%s: %s", f.Species, f.Message)
+	}
+	return fmt.Sprintf("%s %s: %s", f.Slot, f.Species, f.Message)
+}
+
+// Trainer is the player a Pokémon belongs to and the version they play.
+// Pokémon the player caught themselves carry the player's name and ID as their OT.
+type Trainer struct {
+	Name    string
+	ID      uint16
+	Version gamedata.Version
+}
+
+var versionNames = map[gamedata.Version]string{
+	gamedata.VersionRed:    "Red",
+	gamedata.VersionBlue:   "Blue",
+	gamedata.VersionYellow: "Yellow",
+}
+
+// Check returns the findings for a single Pokémon, or nil if it could have been obtained.
+// Stat experience needs no check: it is stored in two bytes, so cannot exceed the game's cap of 65535.
+func Check(p save.Pokemon, t Trainer) []Finding {
+	s, ok := gamedata.SpeciesByIndex(p.Species)
+	if !ok {
+		return []Finding{{
+			Species:  fmt.Sprintf("0x%02X", p.Species),
+			Severity: save.SeverityError,
+			Message:  "species does not exist",
+		}}
+	}
+
+	c := &checker{p: p, s: s, t: t}
+	c.level()
+	c.moves()
+	c.origin()
+	c.data()
+	return c.findings
+}
+
+// CheckSave checks every Pokémon in the party and boxes of f, which is played on version v.
+func CheckSave(f *save.File, v gamedata.Version) ([]Finding, error) {
+	name, err := f.PlayerName()
+	if err != nil {
+		return nil, fmt.Errorf("player name: %w", err)
+	}
+	t := Trainer{Name: name, ID: f.PlayerID(), Version: v}
+
+	var findings []Finding
+	check := func(slot save.Slot, list []save.Pokemon) {
+		for i, p := range list {
+			slot.Index = i
+			for _, finding := range Check(p, t) {
+				finding.Slot = slot.String()
+				findings = append(findings, finding)
+			}
+		}
+	}
+
+	party, err := f.Party()
+	if err != nil {
+		return nil, fmt.Errorf("party: %w", err)
+	}
+	check(save.Slot{Party: true}, party)
+
+	for n := 0; n < f.Layout().Boxes; n++ {
+		box, err := f.Box(n)
+		if err != nil {
+			return nil, fmt.Errorf("box %d: %w", n+1, err)
+		}
+		check(save.Slot{Box: n}, box)
+	}
+
+	return findings, nil
+}
+
+type checker struct {
+	p        save.Pokemon
+	s        gamedata.Species
+	t        Trainer
+	findings []Finding
+}
+
+func (c *checker) report(severity save.Severity, format string, args ...any) {
+	c.findings = append(c.findings, Finding{
+		Species:  c.s.Name,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (c *checker) errorf(format string, args ...any) {
+	c.report(save.SeverityError, format, args...)
+}
+
+func (c *checker) level() {
+	level := int(c.p.Level)
+	if level < 1 || level > 100 {
+		c.errorf("level %d is not between 1 and 100", level)
+		return
+	}
+
+	if lowest := gamedata.MinimumLevel(c.s.Dex); level < lowest {
+		c.errorf("level %d is below %d, the lowest level it can be obtained at", level, lowest)
+	}
+}
+
+func (c *checker) moves() {
+	known := 0
+	for i, id := range c.p.Moves {
+		if id == 0 {
+			continue
+		}
+		known++
+
+		m, ok := gamedata.MoveByID(id)
+		if !ok {
+			c.errorf("move 0x%02X does not exist", id)
+			continue
+		}
+		for _, other := range c.p.Moves[:i] {
+			if other == id {
+				c.errorf("%s is known twice", m.Name)
+			}
+		}
+
+		if !c.learnable(id) {
+			c.errorf("cannot learn %s by level %d", m.Name, c.p.Level)
+		}
+
+		ppUps, pp := c.p.PP[i]>>6, c.p.PP[i]&0x3F
+		if limit := save.MaxPP(m.PP, ppUps); pp > limit {
+			c.errorf("%s has %d PP, more than its maximum of %d with %d PP Ups", m.Name, pp, limit, ppUps)
+		}
+	}
+
+	if known == 0 {
+		c.errorf("knows no moves")
+	}
+}
+
+// learnable reports whether the species, or one it evolved from, can learn the move by the Pokémon's level.
+func (c *checker) learnable(id byte) bool {
+	dex := c.s.Dex
+	for {
+		l, ok := gamedata.LearnsetByDex(dex, c.t.Version)
+		if ok && (l.CanLearnByLevel(id, int(c.p.Level)) || l.CanLearnByMachine(id)) {
+			return true
+		}
+
+		e, ok := gamedata.EvolvesFrom(dex)
+		if !ok {
+			return false
+		}
+		dex = e.From
+	}
+}
+
+// origin checks Pokémon that carry the player as their OT could have been caught or received by them.
+func (c *checker) origin() {
+	if c.p.OTName != c.t.Name || c.p.OTID != c.t.ID {
+		return
+	}
+
+	switch {
+	case c.s.Dex == 151:
+		c.errorf("was only given out at events, so cannot have the player as its OT")
+	case !gamedata.Obtainable(c.s.Dex, c.t.Version):
+		c.errorf("cannot be obtained in %s without trading, so cannot have the player as its OT", versionNames[c.t.Version])
+	}
+}
+
+// data checks the values derived from the species and level match them.
+func (c *checker) data() {
+	if c.p.Type1 != byte(c.s.Types[0]) || c.p.Type2 != byte(c.s.Types[1]) {
+		c.errorf("types %s/%s do not match the species' %s/%s", gamedata.Type(c.p.Type1), gamedata.Type(c.p.Type2), c.s.Types[0], c.s.Types[1])
+	}
+
+	level := int(c.p.Level)
+	if level < 1 || level > 100 {
+		return
+	}
+	if c.s.GrowthRate.LevelForExp(c.p.Exp) != level {
+		c.errorf("%d experience is not within level %d", c.p.Exp, level)
+	}
+
+	// Only party Pokémon store their stats.
+	if c.p.Stats == (save.Stats{}) {
+		return
+	}
+	expected := c.p
+	if err := expected.UpdateStats(); err != nil {
+		return
+	}
+	if c.p.Stats != expected.Stats {
+		c.errorf("stats do not match those calculated from its level, DVs and stat experience")
+	}
+	if c.p.HP > c.p.Stats.HP {
+		c.errorf("HP %d is above its maximum of %d", c.p.HP, c.p.Stats.HP)
+	}
+}
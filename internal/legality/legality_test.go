@@ -0,0 +1,137 @@
+package legality_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"pokegen/internal/gamedata"
+	"pokegen/internal/legality"
+	"pokegen/internal/pokegen"
+	"pokegen/internal/save"
+	"testing"
+)
+
+func pokemon(t *testing.T, species string, level int, moves ...string) pokegen.Pokemon {
+	s, ok := gamedata.SpeciesByName(species)
+	assert.True(t, ok, species)
+
+	p := pokegen.Pokemon{Species: s.Index, Level: level}
+	for _, name := range moves {
+		m, ok := gamedata.MoveByName(name)
+		assert.True(t, ok, name)
+		p.Moves = append(p.Moves, m.ID)
+	}
+	return p
+}
+
+func check(t *testing.T, game pokegen.Game, party ...pokegen.Pokemon) []legality.Finding {
+	findings, err := pokegen.CheckLegality(pokegen.Options{Game: game, Party: party})
+	assert.NoError(t, err)
+	return findings
+}
+
+func TestCheck_Legal(t *testing.T) {
+	findings := check(t, pokegen.GameRed,
+		pokemon(t, "Bulbasaur", 5, "Tackle", "Growl"),
+		// Leech Seed is learnt as a Bulbasaur and Thunderbolt from a TM.
+		pokemon(t, "Venusaur", 40, "Leech Seed", "Razor Leaf", "Hyper Beam", "Cut"),
+		pokemon(t, "Pikachu", 30, "Thunderbolt", "Swift", "Quick Attack", "Thunder Wave"),
+		pokemon(t, "Gyarados", 15, "Splash", "Tackle"),
+	)
+	assert.Empty(t, findings)
+}
+
+func TestCheck_UnlearnableMove(t *testing.T) {
+	findings := check(t, pokegen.GameRed,
+		pokemon(t, "Charmander", 5, "Scratch", "Surf"),
+		pokemon(t, "Bulbasaur", 10, "Tackle", "Vine Whip"),
+	)
+
+	assert.Equal(t, []legality.Finding{
+		{Slot: "party:1", Species: "CHARMANDER", Severity: save.SeverityError, Message: "cannot learn SURF by level 5"},
+		{Slot: "party:2", Species: "BULBASAUR", Severity: save.SeverityError, Message: "cannot learn VINE WHIP by level 10"},
+	}, findings)
+}
+
+func TestCheck_UnlearnableMoveInYellow(t *testing.T) {
+	findings := check(t, pokegen.GameYellow, pokemon(t, "Charmander", 5, "Scratch", "Surf"))
+	assert.Equal(t, []legality.Finding{
+		{Slot: "party:1", Species: "CHARMANDER", Severity: save.SeverityError, Message: "cannot learn SURF by level 5"},
+	}, findings)
+
+	// Pikachu learns Slam at level 20 in Yellow, but never in Red and Blue.
+	assert.Empty(t, check(t, pokegen.GameYellow, pokemon(t, "Pikachu", 20, "Thundershock", "Slam")))
+	assert.Equal(t, []legality.Finding{
+		{Slot: "party:1", Species: "PIKACHU", Severity: save.SeverityError, Message: "cannot learn SLAM by level 20"},
+	}, check(t, pokegen.GameRed, pokemon(t, "Pikachu", 20, "Thundershock", "Slam")))
+}
+
+func TestCheck_BelowEvolutionLevel(t *testing.T) {
+	findings := check(t, pokegen.GameRed, pokemon(t, "Charizard", 20, "Scratch"))
+
+	assert.Equal(t, []legality.Finding{
+		{Slot: "party:1", Species: "CHARIZARD", Severity: save.SeverityError, Message: "level 20 is below 36, the lowest level it can be obtained at"},
+	}, findings)
+}
+
+func TestCheck_Mew(t *testing.T) {
+	findings := check(t, pokegen.GameRed, pokemon(t, "Mew", 5, "Pound"))
+	assert.Equal(t, []legality.Finding{
+		{Slot: "party:1", Species: "MEW", Severity: save.SeverityError, Message: "was only given out at events, so cannot have the player as its OT"},
+	}, findings)
+
+	event := pokemon(t, "Mew", 5, "Pound")
+	event.OTName = "GF"
+	assert.Empty(t, check(t, pokegen.GameRed, event))
+}
+
+func TestCheck_VersionExclusive(t *testing.T) {
+	vulpix := pokemon(t, "Vulpix", 20, "Ember", "Tail Whip")
+
+	assert.Equal(t, []legality.Finding{
+		{Slot: "party:1", Species: "VULPIX", Severity: save.SeverityError, Message: "cannot be obtained in Red without trading, so cannot have the player as its OT"},
+	}, check(t, pokegen.GameRed, vulpix))
+	assert.Empty(t, check(t, pokegen.GameBlue, vulpix))
+
+	id := uint16(12345)
+	vulpix.OTID = &id
+	assert.Empty(t, check(t, pokegen.GameRed, vulpix))
+}
+
+func TestCheck_Data(t *testing.T) {
+	s, _ := gamedata.SpeciesByName("Pidgey")
+	p := save.Pokemon{
+		Species: s.Index,
+		Level:   10,
+		Type1:   byte(gamedata.Normal),
+		Type2:   byte(gamedata.Normal),
+		Moves:   [4]byte{0x10},
+		PP:      [4]byte{0xC0 | 57},
+		Exp:     5,
+	}
+	assert.NoError(t, p.UpdateStats())
+	p.Stats.Speed++
+
+	findings := legality.Check(p, legality.Trainer{Name: "RED", Version: gamedata.VersionRed})
+	messages := make([]string, len(findings))
+	for i, f := range findings {
+		messages[i] = f.Message
+	}
+	assert.Equal(t, []string{
+		"GUST has 57 PP, more than its maximum of 56 with 3 PP Ups",
+		"types NORMAL/NORMAL do not match the species' NORMAL/FLYING",
+		"5 experience is not within level 10",
+		"stats do not match those calculated from its level, DVs and stat experience",
+	}, messages)
+}
+
+func TestCheck_InGameTradeOnly(t *testing.T) {
+	farfetchd := pokemon(t, "Farfetch'd", 20)
+	assert.Equal(t, []legality.Finding{
+		{Slot: "party:1", Species: "FARFETCH'D", Severity: save.SeverityError, Message: "cannot be obtained in Red without trading, so cannot have the player as its OT"},
+	}, check(t, pokegen.GameRed, farfetchd))
+	assert.Empty(t, check(t, pokegen.GameYellow, farfetchd))
+
+	mrMime := pokemon(t, "Mr. Mime", 20)
+	assert.Equal(t, []legality.Finding{
+		{Slot: "party:1", Species: "MR.MIME", Severity: save.SeverityError, Message: "cannot be obtained in Yellow without trading, so cannot have the player as its OT"},
+	}, check(t, pokegen.GameYellow, mrMime))
+}
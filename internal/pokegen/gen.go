@@ -1,28 +1,186 @@
 package pokegen
 
 import (
+	"bytes"
 	"fmt"
 	"io"
+	"pokegen/internal/gamedata"
+	"pokegen/internal/save"
 	"pokegen/internal/util"
 )
 
-func Gen(w io.Writer, playerName, rivalName string, money uint64) ([]byte, error) {
-	err := writeStart(w)
+// Gen writes a save described by opts to w, reconciling it if asked to and recomputing its checksums.
+func Gen(w io.Writer, opts Options) error {
+	f, err := Generate(opts)
+	if err != nil {
+		return err
+	}
+
+	if opts.Reconcile {
+		_, err = f.Reconcile()
+		if err != nil {
+			return fmt.Errorf("reconcile: %w", err)
+		}
+	}
+
+	f.RepairChecksums()
+
+	_, err = w.Write(f.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to write save: %w", err)
+	}
+
+	return nil
+}
+
+// Generate creates the save described by opts, leaving reconciling it, even when opts.Reconcile is set,
+// and recomputing its checksums to the caller, so the fixes reconciling makes can be reported.
+// A new game save is written first, then the options that aren't part of it are applied.
+// Saves for languages other than English are converted from the English save, with the names written afterwards.
+func Generate(opts Options) (*save.File, error) {
+	opts = opts.withDefaults()
+	err := opts.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	base := new(bytes.Buffer)
+
+	err = writeStart(base)
 	if err != nil {
 		return nil, fmt.Errorf("start: %w", err)
 	}
 
-	err = writeMiddle(w, playerName, rivalName, money)
+	playerName, rivalName := opts.PlayerName, opts.RivalName
+	if opts.Language != LanguageEnglish {
+		playerName, rivalName = "", ""
+	}
+
+	err = writeMiddle(base, playerName, rivalName, opts.Money)
 	if err != nil {
 		return nil, fmt.Errorf("middle: %w", err)
 	}
 
-	err = writeEnd(w)
+	err = writeEnd(base)
 	if err != nil {
 		return nil, fmt.Errorf("end: %w", err)
 	}
 
-	return nil, nil
+	f, err := save.Load(base.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("load: %w", err)
+	}
+
+	if opts.Language != LanguageEnglish {
+		f, err = f.Convert(languages[opts.Language].layout)
+		if err != nil {
+			return nil, fmt.Errorf("convert: %w", err)
+		}
+
+		err = f.SetPlayerName(opts.PlayerName)
+		if err != nil {
+			return nil, fmt.Errorf("player name: %w", err)
+		}
+
+		err = f.SetRivalName(opts.RivalName)
+		if err != nil {
+			return nil, fmt.Errorf("rival name: %w", err)
+		}
+	}
+
+	if opts.Game == GameYellow {
+		yellowMissableObjects(f)
+	}
+
+	if opts.Starter != "" {
+		err = chooseStarter(f, opts.Starter)
+		if err != nil {
+			return nil, fmt.Errorf("starter: %w", err)
+		}
+	}
+
+	if toBuild := opts.party(); len(toBuild) > 0 {
+		party := make([]save.Pokemon, len(toBuild))
+		for i, p := range toBuild {
+			party[i], err = p.build(opts.Game, opts.PlayerName, f.PlayerID())
+			if err != nil {
+				return nil, fmt.Errorf("party pokémon %d: %w", i+1, err)
+			}
+		}
+
+		err = f.SetParty(party)
+		if err != nil {
+			return nil, fmt.Errorf("party: %w", err)
+		}
+	}
+
+	// Boxes are written in order, so the current box is in place before the banks are initialised and saved with it.
+	boxes := opts.boxes(f.Layout().BoxCapacity)
+	for n := 0; n < f.Layout().Boxes; n++ {
+		toBuild, ok := boxes[n]
+		if !ok {
+			continue
+		}
+
+		box := make([]save.Pokemon, len(toBuild))
+		for i, p := range toBuild {
+			box[i], err = p.build(opts.Game, opts.PlayerName, f.PlayerID())
+			if err != nil {
+				return nil, fmt.Errorf("box %d pokémon %d: %w", n+1, i+1, err)
+			}
+		}
+
+		err = f.SetBox(n, box)
+		if err != nil {
+			return nil, fmt.Errorf("box %d: %w", n+1, err)
+		}
+	}
+
+	if opts.LivingDex {
+		for _, s := range gamedata.AllSpecies() {
+			f.SetPokedexOwned(s.Dex, true)
+			f.SetPokedexSeen(s.Dex, true)
+		}
+	}
+
+	if opts.Bag != nil {
+		err = f.SetBag(opts.Bag)
+		if err != nil {
+			return nil, fmt.Errorf("bag: %w", err)
+		}
+	}
+	if opts.PCItems != nil {
+		err = f.SetPCItems(opts.PCItems)
+		if err != nil {
+			return nil, fmt.Errorf("pc items: %w", err)
+		}
+	}
+
+	if opts.Badges != 0 {
+		f.SetBadges(opts.Badges)
+	}
+	for _, n := range opts.Events {
+		f.SetEventFlag(n, true)
+	}
+	for n, hidden := range opts.MissableObjects {
+		f.SetMissableObjectHidden(n, hidden)
+	}
+	for _, n := range opts.InGameTrades {
+		f.SetInGameTradeDone(n, true)
+	}
+	if opts.Location != nil {
+		f.SetLocation(*opts.Location)
+	}
+
+	if opts.hasStarterPikachu() {
+		friendship := uint8(starterPikachuFriendship)
+		if opts.PikachuFriendship != nil {
+			friendship = *opts.PikachuFriendship
+		}
+		f.SetPikachuFriendship(friendship)
+	}
+
+	return f, nil
 }
 
 func writeStart(w io.Writer) error {
@@ -626,3 +784,16 @@ func writeEnd(w io.Writer) error {
 
 	return nil
 }
+
+// yellowMissableObjects renumbers the new game's missable objects from Red and Blue's hide and show table to Yellow's.
+// Yellow's Oak's lab holds a single Poké Ball where Red and Blue's holds three, so the objects after it are numbered two lower.
+func yellowMissableObjects(f *save.File) {
+	ball, _ := gamedata.MissableObjectByName("EEVEE_BALL", gamedata.VersionYellow)
+	for n := ball + 1; n < save.MissableObjectCount; n++ {
+		hidden := false
+		if n+2 < save.MissableObjectCount {
+			hidden = f.MissableObjectHidden(n + 2)
+		}
+		f.SetMissableObjectHidden(n, hidden)
+	}
+}
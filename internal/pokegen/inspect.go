@@ -0,0 +1,31 @@
+package pokegen
+
+import (
+	"fmt"
+	"pokegen/internal/gamedata"
+	"pokegen/internal/legality"
+	"pokegen/internal/save"
+)
+
+// CheckLegality generates the save described by opts and checks every Pokémon in it could have been obtained in the game.
+func CheckLegality(opts Options) ([]legality.Finding, error) {
+	f, err := Generate(opts)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Reconcile {
+		if _, err = f.Reconcile(); err != nil {
+			return nil, fmt.Errorf("reconcile: %w", err)
+		}
+	}
+	return legality.CheckSave(f, gamedata.Version(opts.withDefaults().Game))
+}
+
+// Reconcile generates the save described by opts without reconciling it, and returns the fixes reconciling it makes.
+func Reconcile(opts Options) ([]save.Fix, error) {
+	f, err := Generate(opts)
+	if err != nil {
+		return nil, err
+	}
+	return f.Reconcile()
+}
@@ -0,0 +1,38 @@
+package pokegen
+
+import (
+	"pokegen/internal/gamedata"
+)
+
+// livingDexLevel is the lowest level Living Dex Pokémon are placed at, unless the species cannot be obtained that low.
+const livingDexLevel = 5
+
+// livingDexTradeID is the OT ID of Living Dex Pokémon the player could not have caught themselves,
+// which carry the rival as their OT as though traded from them.
+const livingDexTradeID uint16 = 1996
+
+// livingDexBoxes returns the number of boxes a Living Dex fills when each holds capacity Pokémon.
+func livingDexBoxes(capacity int) int {
+	return (len(gamedata.AllSpecies()) + capacity - 1) / capacity
+}
+
+// livingDex returns one Pokémon of every species in Pokédex order, keyed by the zero based box each is placed in,
+// filling boxes of the given capacity from the first.
+// Each is at the lowest level it could be obtained at, from livingDexLevel up, and knows the moves a wild Pokémon would.
+// Species that cannot be caught in the game, including Mew, are traded from the rival.
+func (o Options) livingDex(capacity int) map[int][]Pokemon {
+	boxes := map[int][]Pokemon{}
+	for i, s := range gamedata.AllSpecies() {
+		p := Pokemon{Species: s.Index, Level: livingDexLevel}
+		if lowest := gamedata.MinimumLevel(s.Dex); lowest > p.Level {
+			p.Level = lowest
+		}
+		if s.Dex == 151 || !gamedata.Obtainable(s.Dex, gamedata.Version(o.Game)) {
+			id := livingDexTradeID
+			p.OTName, p.OTID = o.RivalName, &id
+		}
+
+		boxes[i/capacity] = append(boxes[i/capacity], p)
+	}
+	return boxes
+}
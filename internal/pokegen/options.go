@@ -0,0 +1,269 @@
+package pokegen
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"pokegen/internal/gamedata"
+	"pokegen/internal/save"
+	"strings"
+)
+
+// ErrInvalidOptions is returned when the options describe a save that cannot be generated.
+var ErrInvalidOptions = errors.New("invalid options")
+
+// Game is the cartridge the save is generated for.
+type Game string
+
+const (
+	GameRed    Game = "red"
+	GameBlue   Game = "blue"
+	GameYellow Game = "yellow"
+)
+
+// Language is the language of the cartridge the save is generated for.
+type Language string
+
+const (
+	LanguageEnglish  Language = "en"
+	LanguageFrench   Language = "fr"
+	LanguageGerman   Language = "de"
+	LanguageItalian  Language = "it"
+	LanguageSpanish  Language = "es"
+	LanguageJapanese Language = "ja"
+)
+
+// language describes how saves differ between languages.
+type language struct {
+	layout     save.Layout
+	playerName string
+	rivalName  string
+}
+
+var languages = map[Language]language{
+	LanguageEnglish:  {layout: save.International, playerName: "RED", rivalName: "BLUE"},
+	LanguageFrench:   {layout: save.French, playerName: "RED", rivalName: "BLUE"},
+	LanguageGerman:   {layout: save.German, playerName: "ROT", rivalName: "BLAU"},
+	LanguageItalian:  {layout: save.Italian, playerName: "ROSSO", rivalName: "BLU"},
+	LanguageSpanish:  {layout: save.Spanish, playerName: "ROJO", rivalName: "AZUL"},
+	LanguageJapanese: {layout: save.Japanese, playerName: "レッド", rivalName: "グリーン"},
+}
+
+// Options describes the save to generate.
+type Options struct {
+	Game Game
+	// Language defaults to English when empty.
+	Language Language
+	// PlayerName and RivalName default to the names the game suggests in the save's language when empty.
+	PlayerName string
+	RivalName  string
+	Money      uint64
+
+	// Party holds up to six Pokémon to place in the party.
+	Party []Pokemon
+	// Boxes holds the Pokémon to place in each PC box, keyed by zero based box number.
+	Boxes map[int][]Pokemon
+	// LivingDex fills the PC boxes from the first with one of every species in Pokédex order, all marked as owned in the Pokédex.
+	// Boxes may only set the boxes it leaves empty.
+	LivingDex bool
+
+	// Bag and PCItems hold the items in the bag and stored in the PC.
+	Bag     []save.ItemStack
+	PCItems []save.ItemStack
+
+	// Badges is a bit field of the obtained badges, with bit 0 being the Boulder Badge.
+	Badges byte
+	// Events holds the numbers of event flags to set.
+	Events []int
+	// MissableObjects hides, when true, or shows each missable object, such as an item ball or a person who leaves, keyed by number.
+	MissableObjects map[int]bool
+	// InGameTrades holds the numbers of the in-game trades to mark as done, in the order of the game's trade table.
+	InGameTrades []int
+	// Location moves the player when set; the save otherwise starts in the player's bedroom.
+	Location *save.Location
+
+	// Starter, when set, generates the save just after the starter was chosen in Oak's lab, with the starter leading the party.
+	// It is matched ignoring case; Bulbasaur, Charmander and Squirtle are chosen in Red and Blue, and Pikachu in Yellow.
+	Starter Starter
+
+	// Reconcile runs the save's reconciliation pass once everything else is applied,
+	// so fields derived from others, such as the Pokédex flags of Pokémon in the party, agree with them.
+	Reconcile bool
+
+	// PikachuFriendship is the starter Pikachu's friendship, only stored by Yellow saves holding it:
+	// a Pikachu in the party with the player as its OT, such as the one the Pikachu starter gives.
+	// When nil, such saves use 90: the friendship the starter Pikachu is received with.
+	PikachuFriendship *uint8
+}
+
+// withDefaults returns the options with empty fields set to their defaults.
+func (o Options) withDefaults() Options {
+	o.Starter = Starter(strings.ToUpper(string(o.Starter)))
+	if o.Language == "" {
+		o.Language = LanguageEnglish
+	}
+	if lang, ok := languages[o.Language]; ok {
+		if o.PlayerName == "" {
+			o.PlayerName = lang.playerName
+		}
+		if o.RivalName == "" {
+			o.RivalName = lang.rivalName
+		}
+	}
+	return o
+}
+
+func (o Options) validate() error {
+	switch o.Game {
+	case GameRed, GameBlue, GameYellow:
+	default:
+		return fmt.Errorf("unknown game %q: %w", o.Game, ErrInvalidOptions)
+	}
+
+	lang, ok := languages[o.Language]
+	if !ok {
+		return fmt.Errorf("unknown language %q: %w", o.Language, ErrInvalidOptions)
+	}
+
+	if err := lang.layout.Charset.WriteText(io.Discard, o.PlayerName, lang.layout.NameLength); err != nil {
+		return fmt.Errorf("player name %q: %v: %w", o.PlayerName, err, ErrInvalidOptions)
+	}
+	if err := lang.layout.Charset.WriteText(io.Discard, o.RivalName, lang.layout.NameLength); err != nil {
+		return fmt.Errorf("rival name %q: %v: %w", o.RivalName, err, ErrInvalidOptions)
+	}
+
+	if o.Starter != "" {
+		s, ok := starters[o.Starter]
+		if !ok {
+			return fmt.Errorf("unknown starter %q, want %s, %s, %s or %s: %w", o.Starter, StarterBulbasaur, StarterCharmander, StarterSquirtle, StarterPikachu, ErrInvalidOptions)
+		}
+		if s.yellow && o.Game != GameYellow {
+			return fmt.Errorf("pikachu is only the starter in yellow: %w", ErrInvalidOptions)
+		}
+		if !s.yellow && o.Game == GameYellow {
+			return fmt.Errorf("the only starter in yellow is %s: %w", StarterPikachu, ErrInvalidOptions)
+		}
+	}
+
+	if party := o.party(); len(party) > 6 {
+		return fmt.Errorf("party has %d pokémon, the most it can hold is 6: %w", len(party), ErrInvalidOptions)
+	}
+	for i, p := range o.Party {
+		if err := p.validateFor(lang.layout); err != nil {
+			return fmt.Errorf("party pokémon %d: %v: %w", i+1, err, ErrInvalidOptions)
+		}
+	}
+
+	for n, box := range o.Boxes {
+		if n < 0 || n >= lang.layout.Boxes {
+			return fmt.Errorf("box %d does not exist, the PC has %d boxes: %w", n+1, lang.layout.Boxes, ErrInvalidOptions)
+		}
+		if len(box) > lang.layout.BoxCapacity {
+			return fmt.Errorf("box %d has %d pokémon, the most it can hold is %d: %w", n+1, len(box), lang.layout.BoxCapacity, ErrInvalidOptions)
+		}
+		for i, p := range box {
+			if err := p.validateFor(lang.layout); err != nil {
+				return fmt.Errorf("box %d pokémon %d: %v: %w", n+1, i+1, err, ErrInvalidOptions)
+			}
+		}
+	}
+
+	if o.LivingDex {
+		filled := livingDexBoxes(lang.layout.BoxCapacity)
+		for n := range o.Boxes {
+			if n < filled {
+				return fmt.Errorf("box %d is filled by the living dex, which takes boxes 1 to %d: %w", n+1, filled, ErrInvalidOptions)
+			}
+		}
+	}
+
+	if err := validateItems(o.Bag, save.BagCapacity); err != nil {
+		return fmt.Errorf("bag: %v: %w", err, ErrInvalidOptions)
+	}
+	if err := validateItems(o.PCItems, save.PCItemsCapacity); err != nil {
+		return fmt.Errorf("pc items: %v: %w", err, ErrInvalidOptions)
+	}
+
+	for _, n := range o.Events {
+		if n < 0 || n >= gamedata.EventFlagCount {
+			return fmt.Errorf("event flag %d is not between 0 and %d: %w", n, gamedata.EventFlagCount-1, ErrInvalidOptions)
+		}
+	}
+
+	for n := range o.MissableObjects {
+		if n < 0 || n >= save.MissableObjectCount {
+			return fmt.Errorf("missable object %d is not between 0 and %d: %w", n, save.MissableObjectCount-1, ErrInvalidOptions)
+		}
+	}
+
+	for _, n := range o.InGameTrades {
+		if n < 0 || n >= save.InGameTradeCount {
+			return fmt.Errorf("in-game trade %d is not between 0 and %d: %w", n, save.InGameTradeCount-1, ErrInvalidOptions)
+		}
+	}
+
+	if o.Location != nil {
+		if _, ok := gamedata.MapName(o.Location.Map); !ok {
+			return fmt.Errorf("unknown map 0x%02X: %w", o.Location.Map, ErrInvalidOptions)
+		}
+	}
+
+	if o.PikachuFriendship != nil && o.Game != GameYellow {
+		return fmt.Errorf("pikachu friendship is only stored by yellow saves: %w", ErrInvalidOptions)
+	}
+	if o.PikachuFriendship != nil && !o.hasStarterPikachu() {
+		return fmt.Errorf("pikachu friendship needs the starter pikachu in the party, such as from starter %s: %w", StarterPikachu, ErrInvalidOptions)
+	}
+
+	return nil
+}
+
+func validateItems(items []save.ItemStack, capacity int) error {
+	if len(items) > capacity {
+		return fmt.Errorf("has %d items, the most it can hold is %d", len(items), capacity)
+	}
+	for _, stack := range items {
+		if _, ok := gamedata.ItemByID(stack.Item); !ok {
+			return fmt.Errorf("unknown item 0x%02X", stack.Item)
+		}
+		if stack.Quantity < 1 || stack.Quantity > 99 {
+			return fmt.Errorf("quantity %d is not between 1 and 99", stack.Quantity)
+		}
+	}
+	return nil
+}
+
+// boxes returns the Pokémon to place in each PC box of the given capacity, including the Living Dex if one was asked for.
+func (o Options) boxes(capacity int) map[int][]Pokemon {
+	if !o.LivingDex {
+		return o.Boxes
+	}
+	boxes := o.livingDex(capacity)
+	for n, box := range o.Boxes {
+		boxes[n] = box
+	}
+	return boxes
+}
+
+// hasStarterPikachu reports whether the options describe a Yellow save whose party holds the starter Pikachu,
+// which the game recognises as a Pikachu with the player as its OT.
+func (o Options) hasStarterPikachu() bool {
+	if o.Game != GameYellow {
+		return false
+	}
+	pikachu := StarterPikachu.species()
+	for _, p := range o.party() {
+		if p.Species == pikachu.Index && (p.OTName == "" || p.OTName == o.PlayerName) && p.OTID == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// party returns the Pokémon to place in the party, led by the starter if one was chosen.
+func (o Options) party() []Pokemon {
+	if o.Starter == "" {
+		return o.Party
+	}
+	return append([]Pokemon{o.Starter.pokemon()}, o.Party...)
+}
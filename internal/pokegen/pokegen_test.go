@@ -0,0 +1,291 @@
+package pokegen_test
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"pokegen/internal/gamedata"
+	"pokegen/internal/legality"
+	"pokegen/internal/pokegen"
+	"pokegen/internal/save"
+	"testing"
+)
+
+func TestGen_DefaultMoves(t *testing.T) {
+	bulbasaur, _ := gamedata.SpeciesByName("Bulbasaur")
+	pidgey, _ := gamedata.SpeciesByName("Pidgey")
+	mewtwo, _ := gamedata.SpeciesByName("Mewtwo")
+
+	buf := new(bytes.Buffer)
+	err := pokegen.Gen(buf, pokegen.Options{
+		Game: pokegen.GameRed,
+		Party: []pokegen.Pokemon{
+			{Species: bulbasaur.Index, Level: 5},
+			// Gust is forgotten when Wing Attack is learnt at level 28.
+			{Species: pidgey.Index, Level: 30},
+			// Psychic is a base move, so is not learnt again at level 66.
+			{Species: mewtwo.Index, Level: 70},
+		},
+	})
+	assert.NoError(t, err)
+
+	f, err := save.Load(buf.Bytes())
+	assert.NoError(t, err)
+	party, err := f.Party()
+	assert.NoError(t, err)
+
+	// TACKLE, GROWL
+	assert.Equal(t, [4]byte{0x21, 0x2D}, party[0].Moves)
+	assert.Equal(t, [4]byte{35, 40}, party[0].PP)
+	// SAND-ATTACK, QUICK ATTACK, WHIRLWIND, WING ATTACK
+	assert.Equal(t, [4]byte{0x1C, 0x62, 0x12, 0x11}, party[1].Moves)
+	assert.Equal(t, [4]byte{15, 30, 20, 35}, party[1].PP)
+	// SWIFT, PSYCHIC, BARRIER, RECOVER
+	assert.Equal(t, [4]byte{0x81, 0x5E, 0x70, 0x69}, party[2].Moves)
+	assert.Equal(t, [4]byte{20, 10, 30, 20}, party[2].PP)
+}
+
+func TestGen_DefaultMovesYellow(t *testing.T) {
+	pikachu, _ := gamedata.SpeciesByName("Pikachu")
+
+	buf := new(bytes.Buffer)
+	err := pokegen.Gen(buf, pokegen.Options{
+		Game:  pokegen.GameYellow,
+		Party: []pokegen.Pokemon{{Species: pikachu.Index, Level: 15}},
+	})
+	assert.NoError(t, err)
+
+	f, err := save.Load(buf.Bytes())
+	assert.NoError(t, err)
+	party, err := f.Party()
+	assert.NoError(t, err)
+
+	// TAIL WHIP, THUNDER WAVE, QUICK ATTACK, DOUBLE TEAM
+	assert.Equal(t, [4]byte{0x27, 0x56, 0x62, 0x68}, party[0].Moves)
+	assert.Equal(t, [4]byte{30, 20, 30, 15}, party[0].PP)
+}
+
+func TestGen_Starter(t *testing.T) {
+	tauros, _ := gamedata.SpeciesByName("Tauros")
+
+	buf := new(bytes.Buffer)
+	err := pokegen.Gen(buf, pokegen.Options{
+		Game:    pokegen.GameBlue,
+		Starter: "charmander",
+		Party:   []pokegen.Pokemon{{Species: tauros.Index, Level: 10}},
+	})
+	assert.NoError(t, err)
+
+	f, err := save.Load(buf.Bytes())
+	assert.NoError(t, err)
+
+	party, err := f.Party()
+	assert.NoError(t, err)
+	assert.Len(t, party, 2)
+	assert.Equal(t, "CHARMANDER", party[0].Nickname)
+	assert.Equal(t, byte(5), party[0].Level)
+	// SCRATCH, GROWL
+	assert.Equal(t, [4]byte{0x0A, 0x2D}, party[0].Moves)
+	assert.Equal(t, "TAUROS", party[1].Nickname)
+
+	assert.Equal(t, byte(0xB0), f.PlayerStarter(), "player starter should be Charmander")
+	assert.Equal(t, byte(0xB1), f.RivalStarter(), "rival starter should be Squirtle")
+
+	gotStarter, _ := gamedata.EventFlagByName("GOT_STARTER")
+	assert.True(t, f.EventFlag(gotStarter))
+	assert.True(t, f.MissableObjectHidden(0x2B), "Charmander's ball should be taken")
+	assert.True(t, f.MissableObjectHidden(0x2C), "Squirtle's ball should be taken")
+	assert.False(t, f.MissableObjectHidden(0x2D), "Bulbasaur's ball should remain")
+	assert.True(t, f.MissableObjectHidden(0x2A), "the rival should have left the lab")
+	battled, _ := gamedata.EventFlagByName("BATTLED_RIVAL_IN_OAKS_LAB")
+	assert.True(t, f.EventFlag(battled), "the lab battle should be over")
+	assert.True(t, f.PokedexOwned(4))
+}
+
+func TestGen_YellowStarter(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := pokegen.Gen(buf, pokegen.Options{Game: pokegen.GameYellow, Starter: "pikachu"})
+	assert.NoError(t, err)
+
+	f, err := save.Load(buf.Bytes())
+	assert.NoError(t, err)
+
+	party, err := f.Party()
+	assert.NoError(t, err)
+	assert.Len(t, party, 1)
+	assert.Equal(t, "PIKACHU", party[0].Nickname)
+	assert.Equal(t, byte(5), party[0].Level)
+
+	assert.Equal(t, byte(0x54), f.PlayerStarter(), "player starter should be Pikachu")
+	assert.Equal(t, byte(0x66), f.RivalStarter(), "rival starter should be Eevee")
+	assert.Equal(t, byte(90), f.PikachuFriendship())
+	assert.True(t, f.PikachuFollowing())
+	assert.True(t, f.PokedexOwned(25))
+	assert.True(t, f.PokedexSeen(133), "the rival's Eevee should be seen in the lab battle")
+	// Yellow's lab has a single Poké Ball, so its objects are numbered differently from Red and Blue's.
+	assert.True(t, f.MissableObjectHidden(0x2A), "the rival should have left the lab")
+	assert.True(t, f.MissableObjectHidden(0x2B), "Eevee's ball should be taken")
+	assert.False(t, f.MissableObjectHidden(0x2C), "Oak should be in the lab")
+	assert.False(t, f.MissableObjectHidden(0x2D), "the first Pokédex should remain")
+	assert.False(t, f.MissableObjectHidden(0x2E), "the second Pokédex should remain")
+
+	buf.Reset()
+	err = pokegen.Gen(buf, pokegen.Options{Game: pokegen.GameYellow})
+	assert.NoError(t, err)
+	f, err = save.Load(buf.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, byte(0), f.PikachuFriendship(), "friendship should only be stored with the starter Pikachu")
+	assert.False(t, f.PikachuFollowing())
+}
+
+func TestGen_InvalidStarter(t *testing.T) {
+	friendship := uint8(255)
+	for _, opts := range []pokegen.Options{
+		{Game: pokegen.GameRed, Starter: "PIKACHU"},
+		{Game: pokegen.GameYellow, Starter: pokegen.StarterBulbasaur},
+		{Game: pokegen.GameYellow, PikachuFriendship: &friendship},
+		{Game: pokegen.GameYellow, PikachuFriendship: &friendship, Party: []pokegen.Pokemon{{Species: 0x54, Level: 5, OTName: "TRAINER"}}},
+		{Game: pokegen.GameRed, Starter: pokegen.StarterSquirtle, Party: make([]pokegen.Pokemon, 6)},
+	} {
+		err := pokegen.Gen(new(bytes.Buffer), opts)
+		assert.ErrorIs(t, err, pokegen.ErrInvalidOptions, opts.Starter)
+	}
+}
+
+func TestGen_Reconcile(t *testing.T) {
+	tauros, _ := gamedata.SpeciesByName("Tauros")
+	opts := pokegen.Options{
+		Game:  pokegen.GameRed,
+		Party: []pokegen.Pokemon{{Species: tauros.Index, Level: 10}},
+	}
+
+	fixes, err := pokegen.Reconcile(opts)
+	assert.NoError(t, err)
+	assert.Equal(t, []save.Fix{
+		{Field: "pokedex", Message: "marked TAUROS as owned"},
+		{Field: "pokedex", Message: "marked TAUROS as seen"},
+	}, fixes)
+
+	opts.Reconcile = true
+	buf := new(bytes.Buffer)
+	err = pokegen.Gen(buf, opts)
+	assert.NoError(t, err)
+
+	f, err := save.Load(buf.Bytes())
+	assert.NoError(t, err)
+	assert.True(t, f.PokedexOwned(tauros.Dex))
+	assert.True(t, f.PokedexSeen(tauros.Dex))
+}
+
+func TestGen_BoxesItemsAndProgress(t *testing.T) {
+	rattata, _ := gamedata.SpeciesByName("Rattata")
+	pidgey, _ := gamedata.SpeciesByName("Pidgey")
+	beatBrock, _ := gamedata.EventFlagByName("BEAT_BROCK")
+
+	buf := new(bytes.Buffer)
+	err := pokegen.Gen(buf, pokegen.Options{
+		Game: pokegen.GameRed,
+		Boxes: map[int][]pokegen.Pokemon{
+			0: {{Species: rattata.Index, Level: 3}},
+			2: {{Species: pidgey.Index, Level: 3}},
+		},
+		Bag:     []save.ItemStack{{Item: 0x14, Quantity: 3}},
+		PCItems: []save.ItemStack{},
+		Badges:  0x01,
+		Events:  []int{beatBrock},
+		// Hides the Town Map in the rival's house and shows the Viridian City old man who has had his coffee.
+		MissableObjects: map[int]bool{0x29: true, 0x02: false},
+		Location:        &save.Location{Map: 0x01, X: 5, Y: 6},
+	})
+	assert.NoError(t, err)
+
+	f, err := save.Load(buf.Bytes())
+	assert.NoError(t, err)
+	assert.Empty(t, save.Diagnose(f))
+
+	box, err := f.Box(0)
+	assert.NoError(t, err)
+	assert.Len(t, box, 1)
+	assert.Equal(t, "RATTATA", box[0].Nickname)
+	box, err = f.Box(2)
+	assert.NoError(t, err)
+	assert.Len(t, box, 1)
+	assert.Equal(t, "PIDGEY", box[0].Nickname)
+
+	bag, err := f.Bag()
+	assert.NoError(t, err)
+	assert.Equal(t, []save.ItemStack{{Item: 0x14, Quantity: 3}}, bag)
+	pc, err := f.PCItems()
+	assert.NoError(t, err)
+	assert.Empty(t, pc)
+
+	assert.Equal(t, byte(0x01), f.Badges())
+	assert.True(t, f.EventFlag(beatBrock))
+	assert.True(t, f.MissableObjectHidden(0x29))
+	assert.False(t, f.MissableObjectHidden(0x02))
+	assert.Equal(t, save.Location{Map: 0x01, X: 5, Y: 6}, f.Location())
+}
+
+func TestGen_InvalidBoxesAndItems(t *testing.T) {
+	rattata, _ := gamedata.SpeciesByName("Rattata")
+	for _, opts := range []pokegen.Options{
+		{Game: pokegen.GameRed, Boxes: map[int][]pokegen.Pokemon{12: nil}},
+		{Game: pokegen.GameRed, Language: pokegen.LanguageJapanese, Boxes: map[int][]pokegen.Pokemon{8: nil}},
+		{Game: pokegen.GameRed, Boxes: map[int][]pokegen.Pokemon{0: make([]pokegen.Pokemon, 21)}},
+		{Game: pokegen.GameRed, Boxes: map[int][]pokegen.Pokemon{0: {{Species: rattata.Index, Level: 101}}}},
+		{Game: pokegen.GameRed, Bag: make([]save.ItemStack, 21)},
+		{Game: pokegen.GameRed, Bag: []save.ItemStack{{Item: 0x14, Quantity: 100}}},
+		{Game: pokegen.GameRed, PCItems: []save.ItemStack{{Item: 0xFF, Quantity: 1}}},
+		{Game: pokegen.GameRed, Events: []int{gamedata.EventFlagCount}},
+		{Game: pokegen.GameRed, MissableObjects: map[int]bool{save.MissableObjectCount: true}},
+		{Game: pokegen.GameRed, Location: &save.Location{Map: 0x0B}},
+		{Game: pokegen.GameRed, LivingDex: true, Boxes: map[int][]pokegen.Pokemon{7: nil}},
+	} {
+		err := pokegen.Gen(new(bytes.Buffer), opts)
+		assert.ErrorIs(t, err, pokegen.ErrInvalidOptions)
+	}
+}
+
+func TestGen_LivingDex(t *testing.T) {
+	pidgey, _ := gamedata.SpeciesByName("Pidgey")
+
+	for _, game := range []pokegen.Game{pokegen.GameRed, pokegen.GameBlue, pokegen.GameYellow} {
+		buf := new(bytes.Buffer)
+		err := pokegen.Gen(buf, pokegen.Options{
+			Game:      game,
+			LivingDex: true,
+			Boxes:     map[int][]pokegen.Pokemon{8: {{Species: pidgey.Index, Level: 3}}},
+		})
+		assert.NoError(t, err, game)
+
+		f, err := save.Load(buf.Bytes())
+		assert.NoError(t, err, game)
+		assert.Empty(t, save.Diagnose(f), game)
+
+		// Boxes 1 to 6 are stored in one bank and 7 to 12 in the other.
+		var dex []int
+		for n := 0; n < 8; n++ {
+			box, err := f.Box(n)
+			assert.NoError(t, err, game)
+			if n < 7 {
+				assert.Len(t, box, 20, game)
+			}
+			for _, p := range box {
+				s, _ := gamedata.SpeciesByIndex(p.Species)
+				dex = append(dex, s.Dex)
+			}
+		}
+		assert.Len(t, dex, 151, game)
+		for i, n := range dex {
+			assert.Equal(t, i+1, n, game)
+			assert.True(t, f.PokedexOwned(n), game)
+		}
+
+		box, err := f.Box(8)
+		assert.NoError(t, err, game)
+		assert.Len(t, box, 1, "boxes after the living dex can still be set")
+
+		findings, err := legality.CheckSave(f, gamedata.Version(game))
+		assert.NoError(t, err, game)
+		assert.Empty(t, findings, game)
+	}
+}
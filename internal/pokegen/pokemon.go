@@ -0,0 +1,124 @@
+package pokegen
+
+import (
+	"fmt"
+	"io"
+	"pokegen/internal/gamedata"
+	"pokegen/internal/save"
+)
+
+// Pokemon describes a Pokémon to generate.
+// Stats, experience, types, catch rate and PP are derived from the species, level and moves.
+type Pokemon struct {
+	// Species is the internal species index.
+	Species byte
+	Level   int
+	// Moves holds up to four move IDs.
+	// When empty, the Pokémon knows the moves a wild Pokémon of its species and level would.
+	Moves []byte
+	// DVs holds the Attack, Defense, Speed and Special determinant values; the HP DV is derived from them.
+	DVs     save.Stats
+	StatExp save.Stats
+
+	// Nickname defaults to the species name when empty.
+	Nickname string
+	// OTName and OTID default to the player's name and ID.
+	OTName string
+	OTID   *uint16
+}
+
+func (p Pokemon) validate() error {
+	if _, ok := gamedata.SpeciesByIndex(p.Species); !ok {
+		return fmt.Errorf("unknown species 0x%02X", p.Species)
+	}
+
+	if p.Level < 1 || p.Level > 100 {
+		return fmt.Errorf("level %d is not between 1 and 100", p.Level)
+	}
+
+	if len(p.Moves) > 4 {
+		return fmt.Errorf("got %d moves, want at most 4", len(p.Moves))
+	}
+	for i, id := range p.Moves {
+		if _, ok := gamedata.MoveByID(id); !ok {
+			return fmt.Errorf("unknown move 0x%02X", id)
+		}
+		for _, other := range p.Moves[:i] {
+			if other == id {
+				return fmt.Errorf("move 0x%02X is known twice", id)
+			}
+		}
+	}
+
+	for _, dv := range []uint16{p.DVs.Attack, p.DVs.Defense, p.DVs.Speed, p.DVs.Special} {
+		if dv > 15 {
+			return fmt.Errorf("DV %d is not between 0 and 15", dv)
+		}
+	}
+
+	return nil
+}
+
+// validateFor validates the Pokémon and that its names can be written in the layout's character set.
+func (p Pokemon) validateFor(layout save.Layout) error {
+	if err := p.validate(); err != nil {
+		return err
+	}
+	if err := layout.Charset.WriteText(io.Discard, p.Nickname, layout.NameLength); err != nil {
+		return fmt.Errorf("nickname %q: %v", p.Nickname, err)
+	}
+	if err := layout.Charset.WriteText(io.Discard, p.OTName, layout.NameLength); err != nil {
+		return fmt.Errorf("OT name %q: %v", p.OTName, err)
+	}
+	return nil
+}
+
+// build creates the Pokémon as it is stored in a save of the game, healthy and at the minimum experience for its level.
+func (p Pokemon) build(game Game, playerName string, playerID uint16) (save.Pokemon, error) {
+	s, ok := gamedata.SpeciesByIndex(p.Species)
+	if !ok {
+		return save.Pokemon{}, fmt.Errorf("unknown species 0x%02X", p.Species)
+	}
+
+	built := save.Pokemon{
+		Species:   s.Index,
+		Level:     byte(p.Level),
+		Type1:     byte(s.Types[0]),
+		Type2:     byte(s.Types[1]),
+		CatchRate: s.CatchRate,
+		OTID:      playerID,
+		Exp:       s.GrowthRate.ExpForLevel(p.Level),
+		StatExp:   p.StatExp,
+		DVs:       save.PackDVs(p.DVs),
+		OTName:    playerName,
+		Nickname:  s.Name,
+	}
+
+	moves := p.Moves
+	if len(moves) == 0 {
+		learnset, _ := gamedata.LearnsetByDex(s.Dex, gamedata.Version(game))
+		moves = learnset.MovesAtLevel(p.Level)
+	}
+	for i, id := range moves {
+		m, _ := gamedata.MoveByID(id)
+		built.Moves[i] = m.ID
+		built.PP[i] = m.PP
+	}
+
+	if p.Nickname != "" {
+		built.Nickname = p.Nickname
+	}
+	if p.OTName != "" {
+		built.OTName = p.OTName
+	}
+	if p.OTID != nil {
+		built.OTID = *p.OTID
+	}
+
+	if err := built.UpdateStats(); err != nil {
+		return save.Pokemon{}, err
+	}
+	built.HP = built.Stats.HP
+
+	return built, nil
+}
@@ -0,0 +1,118 @@
+package pokegen
+
+import (
+	"fmt"
+	"pokegen/internal/gamedata"
+	"pokegen/internal/save"
+)
+
+// Starter is a species offered in Oak's lab in Red and Blue, or the Pikachu Oak gives the player in Yellow.
+type Starter string
+
+const (
+	StarterBulbasaur  Starter = "BULBASAUR"
+	StarterCharmander Starter = "CHARMANDER"
+	StarterSquirtle   Starter = "SQUIRTLE"
+	StarterPikachu    Starter = "PIKACHU"
+)
+
+// starter describes the game a starter is chosen in, the missable objects of the lab's Poké Balls taken by the player and the rival,
+// and the species the rival takes.
+type starter struct {
+	yellow bool
+	balls  []string
+	rival  string
+}
+
+var starters = map[Starter]starter{
+	StarterCharmander: {balls: []string{"STARTER_BALL_1", "STARTER_BALL_2"}, rival: "SQUIRTLE"},
+	StarterSquirtle:   {balls: []string{"STARTER_BALL_2", "STARTER_BALL_3"}, rival: "BULBASAUR"},
+	StarterBulbasaur:  {balls: []string{"STARTER_BALL_3", "STARTER_BALL_1"}, rival: "CHARMANDER"},
+	// Oak gives the player Pikachu himself, and the rival takes the Eevee in the lab's only Poké Ball.
+	StarterPikachu: {yellow: true, balls: []string{"EEVEE_BALL"}, rival: "EEVEE"},
+}
+
+// version returns the version the starter's missable objects are numbered by.
+func (s starter) version() gamedata.Version {
+	if s.yellow {
+		return gamedata.VersionYellow
+	}
+	return gamedata.VersionRed
+}
+
+// starterEvents are set once the player has followed Oak into the lab, chosen a starter and battled the rival there.
+var starterEvents = []string{
+	"OAK_APPEARED_IN_PALLET",
+	"FOLLOWED_OAK_INTO_LAB",
+	"FOLLOWED_OAK_INTO_LAB_2",
+	"OAK_ASKED_TO_CHOOSE_MON",
+	"GOT_STARTER",
+	"BATTLED_RIVAL_IN_OAKS_LAB",
+}
+
+// starterLevel is the level starters are received at.
+const starterLevel = 5
+
+// species returns the starter's species.
+func (s Starter) species() gamedata.Species {
+	species, _ := gamedata.SpeciesByName(string(s))
+	return species
+}
+
+// starterPikachuFriendship is the friendship Yellow's starter Pikachu is received with.
+const starterPikachuFriendship = 90
+
+// pokemon returns the starter as received from Oak.
+func (s Starter) pokemon() Pokemon {
+	return Pokemon{
+		Species: s.species().Index,
+		Level:   starterLevel,
+	}
+}
+
+// chooseStarter leaves the save as it is once the player and then the rival have chosen their starters and battled:
+// their Poké Balls taken from the table, Oak standing in his lab, the rival gone from it, the starters recorded
+// and the lab's events set, so the story continues with the errand to Viridian City's Poké Mart.
+// In Yellow, the starter Pikachu follows the player from then on.
+// The starter itself is placed in the party by Gen.
+func chooseStarter(f *save.File, s Starter) error {
+	chosen := starters[s]
+	rival, ok := gamedata.SpeciesByName(chosen.rival)
+	if !ok {
+		return fmt.Errorf("unknown species %s", chosen.rival)
+	}
+
+	for _, name := range starterEvents {
+		n, ok := gamedata.EventFlagByName(name)
+		if !ok {
+			return fmt.Errorf("unknown event %s", name)
+		}
+		f.SetEventFlag(n, true)
+	}
+
+	missables := map[string]bool{"OAKS_LAB_OAK_1": false, "OAKS_LAB_RIVAL": true}
+	for _, ball := range chosen.balls {
+		missables[ball] = true
+	}
+	for name, hidden := range missables {
+		n, ok := gamedata.MissableObjectByName(name, chosen.version())
+		if !ok {
+			return fmt.Errorf("unknown missable object %s", name)
+		}
+		f.SetMissableObjectHidden(n, hidden)
+	}
+
+	f.SetPlayerStarter(s.species().Index)
+	f.SetRivalStarter(rival.Index)
+
+	dex := s.species().Dex
+	f.SetPokedexSeen(dex, true)
+	f.SetPokedexOwned(dex, true)
+	// The rival's starter was seen in the battle.
+	f.SetPokedexSeen(rival.Dex, true)
+
+	if chosen.yellow {
+		f.SetPikachuFollowing(true)
+	}
+	return nil
+}
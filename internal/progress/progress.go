@@ -0,0 +1,199 @@
+// Package progress describes saves at typical points of a playthrough, identified by the number of badges earned.
+package progress
+
+import (
+	"errors"
+	"fmt"
+	"pokegen/internal/gamedata"
+	"pokegen/internal/pokegen"
+	"pokegen/internal/random"
+	"pokegen/internal/save"
+)
+
+// ErrInvalidBadges is returned when the badge count is not between 0 and 8.
+var ErrInvalidBadges = errors.New("invalid badge count")
+
+type item struct {
+	name     string
+	quantity byte
+}
+
+// milestone is where a typical playthrough stands just after earning a badge:
+// the team's level and size, the money and items carried, and the city of the gym just beaten,
+// with the player standing outside its Pokémon Center.
+type milestone struct {
+	level     int
+	partySize int
+	money     uint64
+	city      string
+	x, y      byte
+	bag       []item
+}
+
+// milestones are indexed by badge count, with gyms taken in the usual order, which is also the order of the badges' bits.
+var milestones = [9]milestone{
+	{level: 8, partySize: 2, money: 1500, city: "VIRIDIAN CITY", x: 23, y: 26, bag: []item{
+		{"POKé BALL", 5}, {"POTION", 5},
+	}},
+	{level: 14, partySize: 3, money: 3000, city: "PEWTER CITY", x: 13, y: 26, bag: []item{
+		{"POKé BALL", 10}, {"POTION", 5}, {"ANTIDOTE", 2},
+	}},
+	{level: 21, partySize: 4, money: 5000, city: "CERULEAN CITY", x: 19, y: 18, bag: []item{
+		{"POKé BALL", 10}, {"POTION", 5}, {"SUPER POTION", 3}, {"ANTIDOTE", 3}, {"REPEL", 3},
+	}},
+	{level: 26, partySize: 5, money: 8000, city: "VERMILION CITY", x: 11, y: 4, bag: []item{
+		{"BICYCLE", 1}, {"GREAT BALL", 5}, {"SUPER POTION", 5}, {"ANTIDOTE", 3}, {"PARLYZ HEAL", 3}, {"REPEL", 5},
+	}},
+	{level: 32, partySize: 6, money: 15000, city: "CELADON CITY", x: 41, y: 10, bag: []item{
+		{"BICYCLE", 1}, {"GREAT BALL", 10}, {"SUPER POTION", 8}, {"REVIVE", 2}, {"ANTIDOTE", 3}, {"PARLYZ HEAL", 3}, {"SUPER REPEL", 5},
+	}},
+	{level: 40, partySize: 6, money: 20000, city: "FUCHSIA CITY", x: 19, y: 28, bag: []item{
+		{"BICYCLE", 1}, {"GREAT BALL", 10}, {"SUPER POTION", 10}, {"REVIVE", 3}, {"ANTIDOTE", 5}, {"PARLYZ HEAL", 3}, {"SUPER REPEL", 5},
+	}},
+	{level: 45, partySize: 6, money: 30000, city: "SAFFRON CITY", x: 25, y: 30, bag: []item{
+		{"BICYCLE", 1}, {"ULTRA BALL", 10}, {"HYPER POTION", 5}, {"SUPER POTION", 5}, {"REVIVE", 5}, {"SUPER REPEL", 5},
+	}},
+	{level: 48, partySize: 6, money: 40000, city: "CINNABAR ISLAND", x: 11, y: 12, bag: []item{
+		{"BICYCLE", 1}, {"ULTRA BALL", 10}, {"HYPER POTION", 10}, {"REVIVE", 5}, {"FULL HEAL", 5}, {"MAX REPEL", 5},
+	}},
+	{level: 52, partySize: 6, money: 50000, city: "VIRIDIAN CITY", x: 23, y: 26, bag: []item{
+		{"BICYCLE", 1}, {"ULTRA BALL", 15}, {"HYPER POTION", 10}, {"FULL RESTORE", 3}, {"REVIVE", 8}, {"FULL HEAL", 5}, {"MAX REPEL", 5},
+	}},
+}
+
+// storyItems are carried in every save: the Town Map given by the rival's sister.
+var storyItems = []item{
+	{"TOWN MAP", 1},
+}
+
+// storyEvents are set in every save: the player has chosen a starter, delivered Oak's Parcel and been given the Town Map.
+var storyEvents = []string{
+	"OAK_APPEARED_IN_PALLET",
+	"FOLLOWED_OAK_INTO_LAB",
+	"FOLLOWED_OAK_INTO_LAB_2",
+	"OAK_ASKED_TO_CHOOSE_MON",
+	"GOT_STARTER",
+	"BATTLED_RIVAL_IN_OAKS_LAB",
+	"GOT_OAKS_PARCEL",
+	"OAK_GOT_PARCEL",
+	"GOT_POKEDEX",
+	"GOT_POKEBALLS_FROM_OAK",
+	"PALLET_AFTER_GETTING_POKEBALLS",
+	"PALLET_AFTER_GETTING_POKEBALLS_2",
+	"GOT_TOWN_MAP",
+}
+
+// storyMissables are hidden, when true, or shown in every save, leaving the Pokédexes and the rival gone from Oak's lab,
+// the Town Map gone from the rival's house and the old man no longer lying across Viridian City's road north.
+var storyMissables = map[string]bool{
+	"OAKS_LAB_RIVAL": true,
+	"POKEDEX_1":      true,
+	"POKEDEX_2":      true,
+	"TOWN_MAP":       true,
+	"LYING_OLD_MAN":  true,
+	"OLD_MAN":        false,
+}
+
+// gymEvents are set on beating each gym, in the order of milestones.
+var gymEvents = [8][]string{
+	{"BEAT_BROCK", "GOT_TM34"},
+	{"BEAT_MISTY", "GOT_TM11"},
+	{"BEAT_LT_SURGE", "GOT_TM24"},
+	{"BEAT_ERIKA", "GOT_TM21"},
+	{"BEAT_KOGA", "GOT_TM06"},
+	{"BEAT_SABRINA", "GOT_TM46"},
+	{"BEAT_BLAINE", "GOT_TM38"},
+	{"BEAT_VIRIDIAN_GYM_GIOVANNI", "GOT_TM27"},
+}
+
+// Apply returns opts changed to match a typical playthrough that has earned the given number of badges.
+// The badges, money, bag and location are replaced, and the events and missable objects of the story and the gyms beaten are set.
+// Party Pokémon left without a level are given the typical one, or an empty party is filled with a random team of that size
+// at levels around it, always the same team for the same game and badge count.
+// The starter, when set, takes one of the team's places.
+func Apply(opts pokegen.Options, badges int) (pokegen.Options, error) {
+	if badges < 0 || badges >= len(milestones) {
+		return pokegen.Options{}, fmt.Errorf("%w: %d is not between 0 and %d", ErrInvalidBadges, badges, len(milestones)-1)
+	}
+	m := milestones[badges]
+
+	opts.Badges = byte(1<<badges - 1)
+	opts.Money = m.money
+
+	location, err := m.location()
+	if err != nil {
+		return pokegen.Options{}, err
+	}
+	opts.Location = &location
+
+	bag := append(storyItems[:len(storyItems):len(storyItems)], m.bag...)
+	opts.Bag = make([]save.ItemStack, len(bag))
+	for i, it := range bag {
+		item, ok := gamedata.ItemByName(it.name)
+		if !ok {
+			return pokegen.Options{}, fmt.Errorf("unknown item %q", it.name)
+		}
+		opts.Bag[i] = save.ItemStack{Item: item.ID, Quantity: it.quantity}
+	}
+
+	events := storyEvents
+	for _, gym := range gymEvents[:badges] {
+		events = append(events[:len(events):len(events)], gym...)
+	}
+	for _, name := range events {
+		n, ok := gamedata.EventFlagByName(name)
+		if !ok {
+			return pokegen.Options{}, fmt.Errorf("unknown event %s", name)
+		}
+		opts.Events = append(opts.Events, n)
+	}
+
+	missables := make(map[int]bool, len(opts.MissableObjects)+len(storyMissables))
+	for n, hidden := range opts.MissableObjects {
+		missables[n] = hidden
+	}
+	for name, hidden := range storyMissables {
+		n, ok := gamedata.MissableObjectByName(name, gamedata.Version(opts.Game))
+		if !ok {
+			return pokegen.Options{}, fmt.Errorf("unknown missable object %s", name)
+		}
+		missables[n] = hidden
+	}
+	opts.MissableObjects = missables
+
+	if len(opts.Party) == 0 {
+		size := m.partySize
+		if opts.Starter != "" {
+			size--
+		}
+
+		opts.Party, err = random.Team(random.Constraints{
+			Size:          size,
+			MinLevel:      m.level - 3,
+			MaxLevel:      m.level + 2,
+			NoLegendaries: true,
+		}, gamedata.Version(opts.Game))
+		if err != nil {
+			return pokegen.Options{}, fmt.Errorf("party: %w", err)
+		}
+	} else {
+		party := make([]pokegen.Pokemon, len(opts.Party))
+		for i, p := range opts.Party {
+			if p.Level == 0 {
+				p.Level = m.level
+			}
+			party[i] = p
+		}
+		opts.Party = party
+	}
+
+	return opts, nil
+}
+
+func (m milestone) location() (save.Location, error) {
+	id, ok := gamedata.MapByName(m.city)
+	if !ok {
+		return save.Location{}, fmt.Errorf("unknown map %q", m.city)
+	}
+	return save.Location{Map: id, X: m.x, Y: m.y}, nil
+}
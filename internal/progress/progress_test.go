@@ -0,0 +1,69 @@
+package progress_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"pokegen/internal/gamedata"
+	"pokegen/internal/pokegen"
+	"pokegen/internal/progress"
+	"pokegen/internal/save"
+	"testing"
+)
+
+func TestApply(t *testing.T) {
+	opts, err := progress.Apply(pokegen.Options{
+		Game:  pokegen.GameRed,
+		Party: []pokegen.Pokemon{{Species: 0x54}, {Species: 0x09, Level: 16}},
+	}, 5)
+	assert.NoError(t, err)
+
+	assert.Equal(t, byte(0x1F), opts.Badges)
+	assert.Equal(t, uint64(20000), opts.Money)
+	assert.Equal(t, &save.Location{Map: 0x07, X: 19, Y: 28}, opts.Location, "should be in Fuchsia City")
+	assert.Contains(t, opts.Bag, save.ItemStack{Item: 0x05, Quantity: 1}, "should carry the Town Map")
+	assert.Contains(t, opts.Bag, save.ItemStack{Item: 0x13, Quantity: 10}, "should carry Super Potions")
+	assert.Equal(t, []pokegen.Pokemon{{Species: 0x54, Level: 40}, {Species: 0x09, Level: 16}}, opts.Party, "only levels left out should be filled in")
+
+	assert.Contains(t, opts.Events, 0x022, "should have got a starter")
+	assert.Contains(t, opts.Events, 0x259, "should have beaten Koga")
+	assert.NotContains(t, opts.Events, 0x361, "should not have beaten Sabrina")
+	assert.True(t, opts.MissableObjects[0x29], "the Town Map should be gone from the rival's house")
+}
+
+func TestApply_RandomParty(t *testing.T) {
+	for badges := 0; badges <= 8; badges++ {
+		a, err := progress.Apply(pokegen.Options{Game: pokegen.GameBlue}, badges)
+		assert.NoError(t, err)
+		b, err := progress.Apply(pokegen.Options{Game: pokegen.GameBlue}, badges)
+		assert.NoError(t, err)
+		assert.Equal(t, a.Party, b.Party, "the same badge count should give the same team")
+		assert.NotEmpty(t, a.Party)
+
+		for _, p := range a.Party {
+			s, _ := gamedata.SpeciesByIndex(p.Species)
+			assert.False(t, gamedata.Legendary(s.Dex), s.Name)
+		}
+
+		// Every milestone should describe a save the legality checker finds nothing impossible in.
+		findings, err := pokegen.CheckLegality(a)
+		assert.NoError(t, err, badges)
+		for _, f := range findings {
+			assert.NotEqual(t, save.SeverityError, f.Severity, "%d badges: %s", badges, f)
+		}
+	}
+}
+
+func TestApply_Starter(t *testing.T) {
+	opts, err := progress.Apply(pokegen.Options{Game: pokegen.GameRed, Starter: pokegen.StarterBulbasaur}, 8)
+	assert.NoError(t, err)
+	assert.Len(t, opts.Party, 5, "the starter should take one of the team's six places")
+
+	_, err = pokegen.Generate(opts)
+	assert.NoError(t, err)
+}
+
+func TestApply_InvalidBadges(t *testing.T) {
+	for _, badges := range []int{-1, 9} {
+		_, err := progress.Apply(pokegen.Options{Game: pokegen.GameRed}, badges)
+		assert.ErrorIs(t, err, progress.ErrInvalidBadges)
+	}
+}
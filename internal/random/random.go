@@ -0,0 +1,139 @@
+// Package random builds random teams within constraints, seeded so the same constraints always give the same team.
+package random
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"pokegen/internal/gamedata"
+	"pokegen/internal/pokegen"
+	"pokegen/internal/save"
+)
+
+// ErrInvalidConstraints is returned when the constraints cannot be met.
+var ErrInvalidConstraints = errors.New("invalid constraints")
+
+// Constraints limit the Pokémon a random team is built from.
+// Teams built from the same constraints for the same version are the same.
+type Constraints struct {
+	Seed int64 `json:"seed"`
+	// Size is the number of Pokémon in the team, up to 6. It defaults to 6.
+	Size int `json:"size"`
+	// MinLevel and MaxLevel bound the level of each Pokémon. Both default to 50.
+	MinLevel int `json:"min_level"`
+	MaxLevel int `json:"max_level"`
+
+	// Types limits the team to species with at least one of the types, when set.
+	Types []string `json:"types"`
+	// Exclude lists species that may not be chosen.
+	Exclude       []string `json:"exclude"`
+	NoLegendaries bool     `json:"no_legendaries"`
+	// Stage limits the team to species at that stage of their evolution line, when set:
+	// 1 for species that evolve from none, 2 for their evolutions and 3 for the evolutions of those.
+	Stage        int  `json:"stage"`
+	FullyEvolved bool `json:"fully_evolved"`
+}
+
+const (
+	defaultSize  = 6
+	defaultLevel = 50
+)
+
+func (c Constraints) withDefaults() Constraints {
+	if c.Size == 0 {
+		c.Size = defaultSize
+	}
+	if c.MinLevel == 0 && c.MaxLevel == 0 {
+		c.MinLevel, c.MaxLevel = defaultLevel, defaultLevel
+	} else if c.MaxLevel == 0 {
+		c.MaxLevel = c.MinLevel
+	} else if c.MinLevel == 0 {
+		c.MinLevel = c.MaxLevel
+	}
+	return c
+}
+
+// Team builds a team of different species with random levels and DVs, each knowing the moves a wild Pokémon would.
+// Only species that can be obtained in version v at the level they are given are chosen, so the team is one the player could have caught.
+func Team(c Constraints, v gamedata.Version) ([]pokegen.Pokemon, error) {
+	c = c.withDefaults()
+	candidates, err := c.candidates(v)
+	if err != nil {
+		return nil, err
+	}
+	if len(candidates) < c.Size {
+		return nil, fmt.Errorf("only %d species match, want %d: %w", len(candidates), c.Size, ErrInvalidConstraints)
+	}
+
+	rng := rand.New(rand.NewSource(c.Seed))
+	rng.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+
+	team := make([]pokegen.Pokemon, c.Size)
+	for i, s := range candidates[:c.Size] {
+		lowest := c.MinLevel
+		if m := gamedata.MinimumLevel(s.Dex); m > lowest {
+			lowest = m
+		}
+
+		team[i] = pokegen.Pokemon{
+			Species: s.Index,
+			Level:   lowest + rng.Intn(c.MaxLevel-lowest+1),
+			DVs: save.Stats{
+				Attack:  uint16(rng.Intn(16)),
+				Defense: uint16(rng.Intn(16)),
+				Speed:   uint16(rng.Intn(16)),
+				Special: uint16(rng.Intn(16)),
+			},
+		}
+	}
+	return team, nil
+}
+
+// candidates returns the species, in Pokédex order, the team may be built from.
+func (c Constraints) candidates(v gamedata.Version) ([]gamedata.Species, error) {
+	if c.Size < 1 || c.Size > 6 {
+		return nil, fmt.Errorf("size %d is not between 1 and 6: %w", c.Size, ErrInvalidConstraints)
+	}
+	if c.MinLevel < 1 || c.MaxLevel > 100 || c.MinLevel > c.MaxLevel {
+		return nil, fmt.Errorf("levels %d to %d are not a range between 1 and 100: %w", c.MinLevel, c.MaxLevel, ErrInvalidConstraints)
+	}
+	if c.Stage < 0 || c.Stage > 3 {
+		return nil, fmt.Errorf("stage %d is not between 1 and 3: %w", c.Stage, ErrInvalidConstraints)
+	}
+
+	types := map[gamedata.Type]bool{}
+	for _, name := range c.Types {
+		t, ok := gamedata.TypeByName(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown type %q: %w", name, ErrInvalidConstraints)
+		}
+		types[t] = true
+	}
+
+	excluded := map[int]bool{}
+	for _, name := range c.Exclude {
+		s, ok := gamedata.SpeciesByName(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown species %q: %w", name, ErrInvalidConstraints)
+		}
+		excluded[s.Dex] = true
+	}
+
+	var candidates []gamedata.Species
+	for _, s := range gamedata.AllSpecies() {
+		switch {
+		case excluded[s.Dex],
+			!gamedata.Obtainable(s.Dex, v),
+			gamedata.MinimumLevel(s.Dex) > c.MaxLevel,
+			len(types) > 0 && !types[s.Types[0]] && !types[s.Types[1]],
+			c.NoLegendaries && gamedata.Legendary(s.Dex),
+			c.Stage != 0 && gamedata.Stage(s.Dex) != c.Stage,
+			c.FullyEvolved && len(gamedata.EvolvesInto(s.Dex)) > 0:
+			continue
+		}
+		candidates = append(candidates, s)
+	}
+	return candidates, nil
+}
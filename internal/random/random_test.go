@@ -0,0 +1,95 @@
+package random_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"pokegen/internal/gamedata"
+	"pokegen/internal/pokegen"
+	"pokegen/internal/random"
+	"testing"
+)
+
+func TestTeam_Seeded(t *testing.T) {
+	a, err := random.Team(random.Constraints{Seed: 42}, gamedata.VersionRed)
+	assert.NoError(t, err)
+	b, err := random.Team(random.Constraints{Seed: 42}, gamedata.VersionRed)
+	assert.NoError(t, err)
+	c, err := random.Team(random.Constraints{Seed: 43}, gamedata.VersionRed)
+	assert.NoError(t, err)
+
+	assert.Len(t, a, 6)
+	assert.Equal(t, a, b, "the same seed should give the same team")
+	assert.NotEqual(t, a, c, "different seeds should give different teams")
+}
+
+func TestTeam_Constraints(t *testing.T) {
+	c := random.Constraints{
+		Seed:          7,
+		Size:          4,
+		MinLevel:      20,
+		MaxLevel:      30,
+		Types:         []string{"water", "Ice"},
+		Exclude:       []string{"Lapras"},
+		NoLegendaries: true,
+		FullyEvolved:  true,
+	}
+
+	for seed := int64(0); seed < 20; seed++ {
+		c.Seed = seed
+		team, err := random.Team(c, gamedata.VersionBlue)
+		assert.NoError(t, err)
+		assert.Len(t, team, 4)
+
+		seen := map[byte]bool{}
+		for _, p := range team {
+			s, _ := gamedata.SpeciesByIndex(p.Species)
+			assert.False(t, seen[p.Species], "%s is in the team twice", s.Name)
+			seen[p.Species] = true
+
+			assert.True(t, s.Types[0] == gamedata.Water || s.Types[1] == gamedata.Water || s.Types[0] == gamedata.Ice || s.Types[1] == gamedata.Ice, s.Name)
+			assert.NotEqual(t, "LAPRAS", s.Name)
+			assert.NotEqual(t, "ARTICUNO", s.Name)
+			assert.Empty(t, gamedata.EvolvesInto(s.Dex), s.Name)
+			assert.GreaterOrEqual(t, p.Level, 20)
+			assert.LessOrEqual(t, p.Level, 30)
+			assert.GreaterOrEqual(t, p.Level, gamedata.MinimumLevel(s.Dex), s.Name)
+		}
+	}
+}
+
+func TestTeam_Legal(t *testing.T) {
+	for seed := int64(0); seed < 20; seed++ {
+		team, err := random.Team(random.Constraints{Seed: seed, MinLevel: 5, MaxLevel: 60}, gamedata.VersionRed)
+		assert.NoError(t, err)
+
+		findings, err := pokegen.CheckLegality(pokegen.Options{Game: pokegen.GameRed, Party: team})
+		assert.NoError(t, err)
+		assert.Empty(t, findings, "seed %d", seed)
+	}
+}
+
+func TestTeam_Stage(t *testing.T) {
+	team, err := random.Team(random.Constraints{Stage: 3, MinLevel: 5, MaxLevel: 100}, gamedata.VersionRed)
+	assert.NoError(t, err)
+	for _, p := range team {
+		s, _ := gamedata.SpeciesByIndex(p.Species)
+		assert.Equal(t, 3, gamedata.Stage(s.Dex), s.Name)
+	}
+}
+
+func TestTeam_InvalidConstraints(t *testing.T) {
+	for _, c := range []random.Constraints{
+		{Size: 7},
+		{MinLevel: 60, MaxLevel: 50},
+		{MaxLevel: 101},
+		{Stage: 4},
+		{Types: []string{"Fairy"}},
+		{Exclude: []string{"Togepi"}},
+		// Only Dragonite is a fully evolved Dragon type.
+		{Types: []string{"Dragon"}, FullyEvolved: true, MaxLevel: 100, Size: 2},
+		// Dragonite evolves at level 55.
+		{Types: []string{"Dragon"}, FullyEvolved: true, MaxLevel: 50, Size: 1},
+	} {
+		_, err := random.Team(c, gamedata.VersionRed)
+		assert.ErrorIs(t, err, random.ErrInvalidConstraints, "%+v", c)
+	}
+}
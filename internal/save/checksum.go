@@ -0,0 +1,92 @@
+package save
+
+import "fmt"
+
+// Checksum computes the Gen 1 checksum of b: the complement of the 8-bit sum of its bytes.
+func Checksum(b []byte) byte {
+	var sum byte
+	for _, v := range b {
+		sum += v
+	}
+	return ^sum
+}
+
+// ChecksumResult compares a checksum stored in a save with the one computed from its data.
+// Skipped gives the reason a checksum the game does not yet use was not checked.
+type ChecksumResult struct {
+	Name     string `json:"name"`
+	Offset   int    `json:"offset"`
+	Expected byte   `json:"expected"`
+	Actual   byte   `json:"actual"`
+	Skipped  string `json:"skipped,omitempty"`
+}
+
+// Valid reports whether the stored checksum matches the computed one. Skipped checksums are valid.
+func (r ChecksumResult) Valid() bool {
+	return r.Skipped != "" || r.Expected == r.Actual
+}
+
+// boxesUninitialised is the reason box checksums are skipped before the boxes are initialised.
+const boxesUninitialised = "boxes uninitialised, not checked"
+
+// MainChecksum checks the checksum covering the player name through to the end of the main data,
+// which is the checksum the game verifies when loading.
+func (f *File) MainChecksum() ChecksumResult {
+	return ChecksumResult{
+		Name:     "main",
+		Offset:   f.layout.MainChecksum,
+		Expected: Checksum(f.data[f.layout.PlayerName:f.layout.MainChecksum]),
+		Actual:   f.data[f.layout.MainChecksum],
+	}
+}
+
+// BoxChecksums checks the checksums of box banks 2 and 3.
+// Each bank has a checksum covering all of its boxes, followed by a checksum for each box.
+// The banks only hold valid data once the boxes have been initialised, so before then every result is skipped.
+func (f *File) BoxChecksums() []ChecksumResult {
+	skipped := ""
+	if !f.BoxesInitialised() {
+		skipped = boxesUninitialised
+	}
+
+	var results []ChecksumResult
+	for bank, start := range []int{0x4000, 0x6000} {
+		end := start + f.layout.BoxesPerBank*f.layout.boxSize()
+		results = append(results, ChecksumResult{
+			Name:     fmt.Sprintf("bank %d", bank+2),
+			Offset:   end,
+			Expected: Checksum(f.data[start:end]),
+			Actual:   f.data[end],
+			Skipped:  skipped,
+		})
+
+		for i := 0; i < f.layout.BoxesPerBank; i++ {
+			n := bank*f.layout.BoxesPerBank + i
+			boxStart := f.layout.boxOffset(n)
+			results = append(results, ChecksumResult{
+				Name:     fmt.Sprintf("box %d", n+1),
+				Offset:   end + 1 + i,
+				Expected: Checksum(f.data[boxStart : boxStart+f.layout.boxSize()]),
+				Actual:   f.data[end+1+i],
+				Skipped:  skipped,
+			})
+		}
+	}
+	return results
+}
+
+// Checksums checks every checksum in the save.
+func (f *File) Checksums() []ChecksumResult {
+	return append([]ChecksumResult{f.MainChecksum()}, f.BoxChecksums()...)
+}
+
+// RepairChecksums recomputes every checksum in the save that is not skipped, returning the results from before the repair.
+func (f *File) RepairChecksums() []ChecksumResult {
+	results := f.Checksums()
+	for _, r := range results {
+		if r.Skipped == "" {
+			f.data[r.Offset] = r.Expected
+		}
+	}
+	return results
+}
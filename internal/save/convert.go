@@ -0,0 +1,124 @@
+package save
+
+import (
+	"fmt"
+	"pokegen/internal/gamedata"
+)
+
+// Convert returns a copy of the save using another layout, such as when moving an English save to a Japanese cartridge.
+// Names are re-encoded using the new layout's character set, so they must fit its name length and only use characters it has.
+// The Hall of Fame and the battle data between the event flags and the play time are not converted; the game rebuilds the latter.
+func (f *File) Convert(to Layout) (*File, error) {
+	from := f.layout
+	out := &File{data: make([]byte, Size), layout: to}
+
+	// The banks are left erased unless the boxes have been initialised.
+	for i := to.MainChecksum + 1; i < Size; i++ {
+		out.data[i] = 0xFF
+	}
+
+	// Bank 0 and bank 1 up to the player name are laid out the same in every release.
+	copy(out.data[:to.PlayerName], f.data[:from.PlayerName])
+
+	c := converter{from: f, to: out}
+	c.name(from.PlayerName, to.PlayerName, "player name")
+	c.copy(from.PokedexOwned, from.RivalName, to.PokedexOwned)
+	c.name(from.RivalName, to.RivalName, "rival name")
+	c.copy(from.Options, from.EventFlags+gamedata.EventFlagCount/8, to.Options)
+	c.copy(from.PlayTime, from.DayCare+1, to.PlayTime)
+	if f.data[from.DayCare] != 0 {
+		c.name(from.DayCare+1, to.DayCare+1, "day care nickname")
+		c.name(from.DayCare+1+from.NameLength, to.DayCare+1+to.NameLength, "day care OT name")
+	}
+	// The day care Pokémon is followed by the sprite data, which runs up to the party.
+	c.copy(from.DayCare+1+2*from.NameLength, from.Party, to.DayCare+1+2*to.NameLength)
+	c.pokemonList(from.Party, to.Party, partyCapacity, partyCapacity, partyPokemonSize, "party")
+	c.pokemonList(from.BoxData, to.BoxData, from.BoxCapacity, to.BoxCapacity, boxPokemonSize, "current box")
+	// The tile animation byte sits just before the main checksum.
+	out.data[to.MainChecksum-1] = f.data[from.MainChecksum-1]
+
+	if f.CurrentBox() >= to.Boxes {
+		return nil, fmt.Errorf("current box %d does not exist in the new layout", f.CurrentBox()+1)
+	}
+
+	if f.BoxesInitialised() {
+		for n := 0; n < from.Boxes; n++ {
+			if n >= to.Boxes {
+				if f.data[from.boxOffset(n)] != 0 {
+					return nil, fmt.Errorf("box %d does not exist in the new layout", n+1)
+				}
+				continue
+			}
+			c.pokemonList(from.boxOffset(n), to.boxOffset(n), from.BoxCapacity, to.BoxCapacity, boxPokemonSize, fmt.Sprintf("box %d", n+1))
+		}
+		for n := from.Boxes; n < to.Boxes; n++ {
+			if err := out.writePokemonList(to.boxOffset(n), to.BoxCapacity, boxPokemonSize, nil); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	out.RepairChecksums()
+	return out, nil
+}
+
+// converter copies data between two layouts, keeping the first error encountered.
+type converter struct {
+	from, to *File
+	err      error
+}
+
+func (c *converter) copy(start, end, to int) {
+	copy(c.to.data[to:], c.from.data[start:end])
+}
+
+func (c *converter) name(from, to int, field string) {
+	if c.err != nil {
+		return
+	}
+
+	text, err := c.from.layout.Charset.ReadText(c.from.data[from : from+c.from.layout.NameLength])
+	if err != nil {
+		c.err = fmt.Errorf("%s: %w", field, err)
+		return
+	}
+	if err := c.to.writeText(to, text); err != nil {
+		c.err = fmt.Errorf("%s %q: %w", field, text, err)
+	}
+}
+
+// pokemonList converts a list of Pokémon made up of a count, a species list, the Pokémon data, OT names and nicknames.
+func (c *converter) pokemonList(from, to, fromCapacity, toCapacity, size int, field string) {
+	if c.err != nil {
+		return
+	}
+
+	count := int(c.from.data[from])
+	if count > fromCapacity || count > toCapacity {
+		c.err = fmt.Errorf("%s: %d pokémon do not fit in %d slots", field, count, toCapacity)
+		return
+	}
+
+	empty, _ := c.to.layout.encodePokemonList(nil, toCapacity, size)
+	copy(c.to.data[to:], empty)
+	c.to.data[to] = byte(count)
+	c.copy(from+1, from+1+count, to+1)
+	c.to.data[to+1+count] = 0xFF
+
+	fromData, toData := from+1+fromCapacity+1, to+1+toCapacity+1
+	c.copy(fromData, fromData+count*size, toData)
+
+	fromNames, toNames := fromData+fromCapacity*size, toData+toCapacity*size
+	for i := 0; i < count; i++ {
+		fromOT, toOT := fromNames+i*c.from.layout.NameLength, toNames+i*c.to.layout.NameLength
+		c.name(fromOT, toOT, fmt.Sprintf("%s pokémon %d OT name", field, i+1))
+
+		fromNick := fromNames + fromCapacity*c.from.layout.NameLength + i*c.from.layout.NameLength
+		toNick := toNames + toCapacity*c.to.layout.NameLength + i*c.to.layout.NameLength
+		c.name(fromNick, toNick, fmt.Sprintf("%s pokémon %d nickname", field, i+1))
+	}
+}
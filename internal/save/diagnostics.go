@@ -0,0 +1,162 @@
+package save
+
+import (
+	"bytes"
+	"fmt"
+	"pokegen/internal/gamedata"
+	"pokegen/internal/util"
+)
+
+type Severity string
+
+const (
+	// SeverityError is used for problems the game is likely to trip over, such as runaway names or lists.
+	SeverityError Severity = "error"
+	// SeverityWarning is used for data the game tolerates but could not have produced itself.
+	SeverityWarning Severity = "warning"
+)
+
+// Problem is a structural problem found in a save.
+type Problem struct {
+	Severity Severity `json:"severity"`
+	Offset   int      `json:"offset"`
+	Field    string   `json:"field"`
+	Message  string   `json:"message"`
+}
+
+// Diagnose walks the save using its layout and reports structural problems.
+// Unlike Summary it does not stop at the first problem, so it can be used on saves that fail to load in game.
+func Diagnose(f *File) []Problem {
+	d := &diagnoser{f: f}
+	l := f.layout
+
+	for _, c := range f.Checksums() {
+		if !c.Valid() {
+			d.errorf(c.Offset, c.Name+" checksum", "stored 0x%02X, expected 0x%02X", c.Actual, c.Expected)
+		}
+	}
+
+	d.name(l.PlayerName, "player name")
+	d.name(l.RivalName, "rival name")
+	d.bcd(l.Money, 3, "money")
+	d.bcd(l.Coins, 2, "coins")
+	d.items(l.Bag, BagCapacity, "bag")
+	d.items(l.PCItems, PCItemsCapacity, "pc items")
+
+	if f.CurrentBox() >= l.Boxes {
+		d.errorf(l.CurrentBox, "current box", "box %d does not exist", f.CurrentBox()+1)
+	}
+
+	d.pokemonList(l.Party, partyCapacity, partyPokemonSize, "party")
+	d.pokemonList(l.BoxData, l.BoxCapacity, boxPokemonSize, "current box")
+	if f.BoxesInitialised() {
+		for n := 0; n < l.Boxes; n++ {
+			d.pokemonList(l.boxOffset(n), l.BoxCapacity, boxPokemonSize, fmt.Sprintf("box %d", n+1))
+		}
+	}
+
+	return d.problems
+}
+
+type diagnoser struct {
+	f        *File
+	problems []Problem
+}
+
+func (d *diagnoser) errorf(offset int, field, format string, args ...interface{}) {
+	d.problems = append(d.problems, Problem{SeverityError, offset, field, fmt.Sprintf(format, args...)})
+}
+
+func (d *diagnoser) warnf(offset int, field, format string, args ...interface{}) {
+	d.problems = append(d.problems, Problem{SeverityWarning, offset, field, fmt.Sprintf(format, args...)})
+}
+
+// name checks that a name is terminated within its reserved space and uses the character set.
+func (d *diagnoser) name(offset int, field string) {
+	const terminator = 0x50
+	b := d.f.data[offset : offset+d.f.layout.NameLength]
+
+	if bytes.IndexByte(b, terminator) == -1 {
+		d.errorf(offset, field, "missing 0x50 terminator within %d bytes", len(b))
+		return
+	}
+
+	if _, err := d.f.layout.Charset.ReadText(b); err != nil {
+		d.warnf(offset, field, "%v", err)
+	}
+}
+
+func (d *diagnoser) bcd(offset, length int, field string) {
+	if _, err := util.ReadBinaryCodedDecimal(d.f.data[offset : offset+length]); err != nil {
+		d.errorf(offset, field, "%v", err)
+	}
+}
+
+// items checks an item list: a count, item and quantity pairs, and a 0xFF terminator.
+func (d *diagnoser) items(offset, capacity int, field string) {
+	count := int(d.f.data[offset])
+	if count > capacity {
+		d.errorf(offset, field, "count %d exceeds capacity %d", count, capacity)
+		return
+	}
+
+	if terminator := offset + 1 + count*2; d.f.data[terminator] != 0xFF {
+		d.errorf(terminator, field, "missing 0xFF terminator after %d items", count)
+	}
+
+	for i := 0; i < count; i++ {
+		itemOffset := offset + 1 + i*2
+		item, quantity := d.f.data[itemOffset], d.f.data[itemOffset+1]
+		if _, ok := gamedata.ItemByID(item); !ok {
+			d.warnf(itemOffset, fmt.Sprintf("%s[%d]", field, i+1), "invalid item index 0x%02X", item)
+		}
+		if quantity == 0 || quantity > 99 {
+			d.warnf(itemOffset+1, fmt.Sprintf("%s[%d]", field, i+1), "quantity %d is outside 1-99", quantity)
+		}
+	}
+}
+
+// pokemonList checks a list made up of a count, a species list, the Pokémon data, OT names and nicknames.
+func (d *diagnoser) pokemonList(offset, capacity, size int, field string) {
+	count := int(d.f.data[offset])
+	if count > capacity {
+		d.errorf(offset, field, "count %d exceeds capacity %d", count, capacity)
+		return
+	}
+
+	speciesOffset := offset + 1
+	dataOffset := speciesOffset + capacity + 1
+	otNamesOffset := dataOffset + capacity*size
+	nicknamesOffset := otNamesOffset + capacity*d.f.layout.NameLength
+
+	if d.f.data[speciesOffset+count] != 0xFF {
+		d.errorf(speciesOffset+count, field, "species list is missing its 0xFF terminator after %d entries", count)
+	}
+
+	for i := 0; i < count; i++ {
+		slot := fmt.Sprintf("%s[%d]", field, i+1)
+		listed := d.f.data[speciesOffset+i]
+		p := decodePokemon(d.f.data[dataOffset+i*size : dataOffset+(i+1)*size])
+
+		if _, ok := gamedata.SpeciesByIndex(listed); !ok {
+			d.errorf(speciesOffset+i, slot, "invalid species index 0x%02X in species list", listed)
+		}
+		if p.Species != listed {
+			d.errorf(dataOffset+i*size, slot, "species 0x%02X does not match species list entry 0x%02X", p.Species, listed)
+		}
+		if p.Level < 1 || p.Level > 100 {
+			d.warnf(dataOffset+i*size+3, slot, "level %d is outside 1-100", p.Level)
+		}
+		if size == partyPokemonSize && p.HP > p.Stats.HP {
+			d.warnf(dataOffset+i*size+1, slot, "HP %d exceeds max HP %d", p.HP, p.Stats.HP)
+		}
+		for m, move := range p.Moves {
+			if _, ok := gamedata.MoveByID(move); !ok && move != 0 {
+				d.warnf(dataOffset+i*size+8+m, slot, "invalid move index 0x%02X", move)
+			}
+		}
+
+		d.name(otNamesOffset+i*d.f.layout.NameLength, slot+" OT name")
+		d.name(nicknamesOffset+i*d.f.layout.NameLength, slot+" nickname")
+	}
+}
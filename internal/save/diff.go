@@ -0,0 +1,273 @@
+package save
+
+import (
+	"fmt"
+	"pokegen/internal/gamedata"
+)
+
+// Difference is a single change between two saves.
+type Difference struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+func (d Difference) String() string {
+	if (d.Old == "set" || d.Old == "unset") && (d.New == "set" || d.New == "unset") {
+		return fmt.Sprintf("%s %s", d.Field, d.New)
+	}
+	return fmt.Sprintf("%s %s → %s", d.Field, d.Old, d.New)
+}
+
+// Diff compares two saves field by field.
+// Differences in bytes that are not part of a modelled field are reported as annotated byte ranges.
+// Party and box slots are numbered from 1, matching the in-game order.
+func Diff(a, b *File) ([]Difference, error) {
+	sa, err := a.Summary()
+	if err != nil {
+		return nil, fmt.Errorf("first save: %w", err)
+	}
+
+	sb, err := b.Summary()
+	if err != nil {
+		return nil, fmt.Errorf("second save: %w", err)
+	}
+
+	d := &differ{}
+	d.add("player name", sa.PlayerName, sb.PlayerName)
+	d.add("rival name", sa.RivalName, sb.RivalName)
+	d.add("player id", sa.PlayerID, sb.PlayerID)
+	d.add("money", sa.Money, sb.Money)
+	d.add("coins", sa.Coins, sb.Coins)
+	for i, name := range badgeNames {
+		d.flag("badge "+name, a.Badges()&(1<<i) != 0, b.Badges()&(1<<i) != 0)
+	}
+	d.add("play time", formatPlayTime(sa.PlayTime), formatPlayTime(sb.PlayTime))
+	d.add("location", sa.Location.Name, sb.Location.Name)
+	d.add("location x", sa.Location.X, sb.Location.X)
+	d.add("location y", sa.Location.Y, sb.Location.Y)
+	d.add("current box", a.CurrentBox()+1, b.CurrentBox()+1)
+	d.add("pikachu friendship", sa.PikachuFriendship, sb.PikachuFriendship)
+
+	d.pokemonList("party", sa.Party, sb.Party)
+	for i := range sa.Boxes {
+		d.pokemonList(fmt.Sprintf("box %d", i+1), sa.Boxes[i].Pokemon, sb.Boxes[i].Pokemon)
+	}
+	d.items("bag", sa.Bag, sb.Bag)
+	d.items("pc items", sa.PCItems, sb.PCItems)
+
+	for dex := 1; dex <= pokedexSize; dex++ {
+		species, _ := gamedata.SpeciesByDex(dex)
+		d.flag("pokedex owned "+species.Name, a.PokedexOwned(dex), b.PokedexOwned(dex))
+		d.flag("pokedex seen "+species.Name, a.PokedexSeen(dex), b.PokedexSeen(dex))
+	}
+
+	for n := 0; n < gamedata.EventFlagCount; n++ {
+		name, ok := gamedata.EventFlagName(n)
+		if !ok {
+			name = fmt.Sprintf("0x%03X", n)
+		}
+		d.flag("event flag "+name, a.EventFlag(n), b.EventFlag(n))
+	}
+
+	d.add("main checksum", fmt.Sprintf("0x%02X", a.MainChecksum().Actual), fmt.Sprintf("0x%02X", b.MainChecksum().Actual))
+
+	d.bytes(a, b)
+
+	return d.diffs, nil
+}
+
+type differ struct {
+	diffs []Difference
+}
+
+func (d *differ) add(field string, old, new interface{}) {
+	o, n := fmt.Sprint(old), fmt.Sprint(new)
+	if o != n {
+		d.diffs = append(d.diffs, Difference{Field: field, Old: o, New: n})
+	}
+}
+
+func (d *differ) flag(field string, old, new bool) {
+	state := map[bool]string{true: "set", false: "unset"}
+	d.add(field, state[old], state[new])
+}
+
+func (d *differ) pokemonList(prefix string, a, b []PokemonSummary) {
+	d.add(prefix+" count", len(a), len(b))
+
+	for i := 0; i < len(a) || i < len(b); i++ {
+		field := fmt.Sprintf("%s[%d]", prefix, i+1)
+		switch {
+		case i >= len(a):
+			d.add(field, "empty", b[i].Species)
+		case i >= len(b):
+			d.add(field, a[i].Species, "empty")
+		default:
+			d.pokemon(field, a[i], b[i])
+		}
+	}
+}
+
+func (d *differ) pokemon(prefix string, a, b PokemonSummary) {
+	d.add(prefix+".species", a.Species, b.Species)
+	d.add(prefix+".nickname", a.Nickname, b.Nickname)
+	d.add(prefix+".ot_name", a.OTName, b.OTName)
+	d.add(prefix+".ot_id", a.OTID, b.OTID)
+	d.add(prefix+".level", a.Level, b.Level)
+	d.add(prefix+".hp", a.HP, b.HP)
+	d.add(prefix+".status", a.Status, b.Status)
+	d.add(prefix+".exp", a.Exp, b.Exp)
+
+	if a.Stats != nil && b.Stats != nil {
+		d.stats(prefix+".stats", *a.Stats, *b.Stats)
+	}
+	d.stats(prefix+".stat_exp", a.StatExp, b.StatExp)
+	d.stats(prefix+".dvs", a.DVs, b.DVs)
+
+	for i := 0; i < len(a.Moves) || i < len(b.Moves); i++ {
+		field := fmt.Sprintf("%s.moves[%d]", prefix, i+1)
+		switch {
+		case i >= len(a.Moves):
+			d.add(field, "empty", b.Moves[i].Name)
+		case i >= len(b.Moves):
+			d.add(field, a.Moves[i].Name, "empty")
+		default:
+			d.add(field, a.Moves[i].Name, b.Moves[i].Name)
+			d.add(field+".pp", a.Moves[i].PP, b.Moves[i].PP)
+			d.add(field+".pp_ups", a.Moves[i].PPUps, b.Moves[i].PPUps)
+		}
+	}
+}
+
+func (d *differ) stats(prefix string, a, b Stats) {
+	d.add(prefix+".hp", a.HP, b.HP)
+	d.add(prefix+".attack", a.Attack, b.Attack)
+	d.add(prefix+".defense", a.Defense, b.Defense)
+	d.add(prefix+".speed", a.Speed, b.Speed)
+	d.add(prefix+".special", a.Special, b.Special)
+}
+
+func (d *differ) items(prefix string, a, b []ItemSummary) {
+	d.add(prefix+" count", len(a), len(b))
+
+	for i := 0; i < len(a) || i < len(b); i++ {
+		field := fmt.Sprintf("%s[%d]", prefix, i+1)
+		switch {
+		case i >= len(a):
+			d.add(field, "empty", formatItem(b[i]))
+		case i >= len(b):
+			d.add(field, formatItem(a[i]), "empty")
+		default:
+			d.add(field, formatItem(a[i]), formatItem(b[i]))
+		}
+	}
+}
+
+// bytes reports runs of differing bytes that lie outside of the modelled fields.
+func (d *differ) bytes(a, b *File) {
+	modelled := a.modelledBytes()
+	for i, m := range b.modelledBytes() {
+		modelled[i] = modelled[i] || m
+	}
+
+	for start := 0; start < Size; start++ {
+		if modelled[start] || a.data[start] == b.data[start] {
+			continue
+		}
+
+		end := start
+		for end+1 < Size && !modelled[end+1] && a.data[end+1] != b.data[end+1] {
+			end++
+		}
+
+		d.diffs = append(d.diffs, Difference{
+			Field: fmt.Sprintf("bytes 0x%04X-0x%04X (%s)", start, end, a.regionName(start)),
+			Old:   formatBytes(a.data[start : end+1]),
+			New:   formatBytes(b.data[start : end+1]),
+		})
+		start = end
+	}
+}
+
+// modelledBytes marks the bytes that are compared field by field by Diff.
+func (f *File) modelledBytes() []bool {
+	l := f.layout
+	modelled := make([]bool, Size)
+	mark := func(offset, length int) {
+		for i := offset; i < offset+length; i++ {
+			modelled[i] = true
+		}
+	}
+
+	const pokedexBytes = (pokedexSize + 7) / 8
+	mark(l.PlayerName, l.NameLength)
+	mark(l.PokedexOwned, pokedexBytes)
+	mark(l.PokedexSeen, pokedexBytes)
+	mark(l.Bag, 1+BagCapacity*2+1)
+	mark(l.Money, 3)
+	mark(l.RivalName, l.NameLength)
+	mark(l.Badges, 1)
+	mark(l.PlayerID, 2)
+	mark(l.CurrentMap, 1)
+	mark(l.YCoord, 1)
+	mark(l.XCoord, 1)
+	mark(l.PikachuFriendship, 1)
+	mark(l.PCItems, 1+PCItemsCapacity*2+1)
+	mark(l.CurrentBox, 1)
+	mark(l.Coins, 2)
+	mark(l.EventFlags, gamedata.EventFlagCount/8)
+	mark(l.PlayTime, 5)
+	mark(l.Party, 1+partyCapacity+1+partyCapacity*(partyPokemonSize+2*l.NameLength))
+	mark(l.BoxData, l.boxSize())
+	mark(l.MainChecksum, 1)
+
+	if f.BoxesInitialised() {
+		for n := 0; n < l.Boxes; n++ {
+			mark(l.boxOffset(n), l.boxSize())
+		}
+	}
+
+	return modelled
+}
+
+// regionName names the area of the save that offset falls within.
+func (f *File) regionName(offset int) string {
+	const hallOfFame, hallOfFameEnd = 0x0598, 0x1858
+	switch {
+	case offset >= hallOfFame && offset < hallOfFameEnd:
+		return "hall of fame"
+	case offset >= f.layout.PlayerName && offset <= f.layout.MainChecksum:
+		return "main data"
+	case offset < 0x2000:
+		return "bank 0"
+	case offset < 0x4000:
+		return "bank 1"
+	case offset < 0x6000:
+		return "box bank 2"
+	}
+	return "box bank 3"
+}
+
+func formatPlayTime(t PlayTime) string {
+	return fmt.Sprintf("%d:%02d:%02d", t.Hours, t.Minutes, t.Seconds)
+}
+
+func formatItem(item ItemSummary) string {
+	return fmt.Sprintf("%s x%d", item.Name, item.Quantity)
+}
+
+func formatBytes(b []byte) string {
+	const limit = 16
+	s := ""
+	for i, v := range b {
+		if i == limit {
+			return s + fmt.Sprintf(" … (%d bytes)", len(b))
+		}
+		if i > 0 {
+			s += " "
+		}
+		s += fmt.Sprintf("%02X", v)
+	}
+	return s
+}
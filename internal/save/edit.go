@@ -0,0 +1,291 @@
+package save
+
+import (
+	"fmt"
+	"pokegen/internal/gamedata"
+)
+
+// Operations that Edit applies to Pokémon.
+const (
+	OpHeal     = "heal"
+	OpMaxPP    = "max_pp"
+	OpSetLevel = "set_level"
+	OpEvolve   = "evolve"
+	OpSort     = "sort"
+	OpMove     = "move"
+	OpRelease  = "release"
+)
+
+// Operation is a change Edit makes to Pokémon in a save.
+type Operation struct {
+	// Op is one of heal, max_pp, set_level, evolve, sort, move and release.
+	Op string `json:"op"`
+	// Slot limits the operation to one Pokémon, such as party:1, and is required by evolve, move and release.
+	// Without it, the operation applies to every Pokémon in the party and boxes.
+	Slot string `json:"slot,omitempty"`
+	// To is the slot move moves the Pokémon to; the Pokémon there and after it move up a place.
+	To string `json:"to,omitempty"`
+	// Level is the level set_level sets.
+	Level int `json:"level,omitempty"`
+	// Into is the species evolve evolves into, which may be left out for species that only evolve one way.
+	Into string `json:"into,omitempty"`
+	// By is the order sort puts Pokémon in: dex, level or name, lowest first.
+	By string `json:"by,omitempty"`
+	// Box is the box, numbered from 1, that sort sorts.
+	// Without it, every Pokémon in the boxes is sorted together and the boxes are refilled from the first.
+	Box int `json:"box,omitempty"`
+}
+
+func (o Operation) String() string {
+	if o.Slot == "" {
+		return o.Op
+	}
+	return fmt.Sprintf("%s %s", o.Op, o.Slot)
+}
+
+// Edit applies each operation to the save in order. Neither the save nor the Pokémon in it are changed if an operation fails.
+func (f *File) Edit(ops []Operation) error {
+	edited, _ := LoadLayout(f.data, f.layout)
+	for i, op := range ops {
+		if err := edited.apply(op); err != nil {
+			return fmt.Errorf("operation %d (%s): %w", i+1, op, err)
+		}
+	}
+	copy(f.data, edited.data)
+	return nil
+}
+
+func (f *File) apply(op Operation) error {
+	switch op.Op {
+	case OpSort:
+		return f.sortBoxes(op.By, op.Box)
+	case OpMove:
+		return f.move(op.Slot, op.To)
+	case OpRelease:
+		return f.release(op.Slot)
+	}
+
+	var change func(p *Pokemon) error
+	switch op.Op {
+	case OpHeal:
+		change = (*Pokemon).Heal
+	case OpMaxPP:
+		change = (*Pokemon).MaxPP
+	case OpSetLevel:
+		change = func(p *Pokemon) error { return p.SetLevel(op.Level) }
+	case OpEvolve:
+		if op.Slot == "" {
+			return fmt.Errorf("evolve needs the slot of the pokémon to evolve")
+		}
+		change = func(p *Pokemon) error {
+			into, err := evolution(p.Species, op.Into)
+			if err != nil {
+				return err
+			}
+			if err := p.Evolve(into); err != nil {
+				return err
+			}
+			f.SetPokedexSeen(into.Dex, true)
+			f.SetPokedexOwned(into.Dex, true)
+			return nil
+		}
+	default:
+		return fmt.Errorf("unknown operation %q, want %s, %s, %s, %s, %s, %s or %s", op.Op, OpHeal, OpMaxPP, OpSetLevel, OpEvolve, OpSort, OpMove, OpRelease)
+	}
+
+	if op.Slot != "" {
+		s, err := ParseSlot(op.Slot)
+		if err != nil {
+			return err
+		}
+		p, err := f.Pokemon(s)
+		if err != nil {
+			return err
+		}
+		if err := change(&p); err != nil {
+			return err
+		}
+		return f.SetPokemon(s, p)
+	}
+
+	party, err := f.Party()
+	if err != nil {
+		return fmt.Errorf("party: %w", err)
+	}
+	for i := range party {
+		if err := change(&party[i]); err != nil {
+			return fmt.Errorf("%s: %w", Slot{Party: true, Index: i}, err)
+		}
+	}
+	if err := f.SetParty(party); err != nil {
+		return fmt.Errorf("party: %w", err)
+	}
+
+	for n := 0; n < f.layout.Boxes; n++ {
+		box, err := f.Box(n)
+		if err != nil {
+			return fmt.Errorf("box %d: %w", n+1, err)
+		}
+		if len(box) == 0 {
+			continue
+		}
+		for i := range box {
+			if err := change(&box[i]); err != nil {
+				return fmt.Errorf("%s: %w", Slot{Box: n, Index: i}, err)
+			}
+		}
+		if err := f.SetBox(n, box); err != nil {
+			return fmt.Errorf("box %d: %w", n+1, err)
+		}
+	}
+	return nil
+}
+
+// evolution returns the species named into that the species with the given index evolves into,
+// or its only evolution when into is empty.
+func evolution(index byte, into string) (gamedata.Species, error) {
+	s, ok := gamedata.SpeciesByIndex(index)
+	if !ok {
+		return gamedata.Species{}, fmt.Errorf("unknown species 0x%02X", index)
+	}
+
+	evolutions := gamedata.EvolvesInto(s.Dex)
+	if into == "" {
+		if len(evolutions) != 1 {
+			return gamedata.Species{}, fmt.Errorf("%s evolves %d ways, so the species to evolve into is needed", s.Name, len(evolutions))
+		}
+		e, _ := gamedata.SpeciesByDex(evolutions[0].Into)
+		return e, nil
+	}
+
+	e, ok := gamedata.SpeciesByName(into)
+	if !ok {
+		return gamedata.Species{}, fmt.Errorf("unknown species %q", into)
+	}
+	for _, evolution := range evolutions {
+		if evolution.Into == e.Dex {
+			return e, nil
+		}
+	}
+	return gamedata.Species{}, fmt.Errorf("%s does not evolve into %s", s.Name, e.Name)
+}
+
+// MaxPP returns the most PP a move with the given base PP can have: each PP Up adds a fifth of the base PP, up to the 61 PP the game allows.
+func MaxPP(base, ppUps byte) byte {
+	pp := base + base/5*ppUps
+	if pp > 61 {
+		pp = 61
+	}
+	return pp
+}
+
+// Heal restores the Pokémon's HP and PP and clears its status, as a Pokémon Center does.
+func (p *Pokemon) Heal() error {
+	hp, err := p.maxHP()
+	if err != nil {
+		return err
+	}
+	p.HP = hp
+	p.Status = 0
+	p.restorePP()
+	return nil
+}
+
+// MaxPP applies the three PP Ups each move can take and restores the moves' PP.
+func (p *Pokemon) MaxPP() error {
+	for i := range p.PP {
+		p.PP[i] = 3 << 6
+	}
+	p.restorePP()
+	return nil
+}
+
+// restorePP fills the PP of each move up to its maximum with the PP Ups applied to it.
+func (p *Pokemon) restorePP() {
+	for i, id := range p.Moves {
+		m, ok := gamedata.MoveByID(id)
+		if id == 0 || !ok {
+			p.PP[i] = 0
+			continue
+		}
+		ppUps := p.PP[i] >> 6
+		p.PP[i] = ppUps<<6 | MaxPP(m.PP, ppUps)
+	}
+}
+
+// SetLevel moves the Pokémon to level with the experience it starts the level with, recalculating its stats.
+func (p *Pokemon) SetLevel(level int) error {
+	if level < 1 || level > 100 {
+		return fmt.Errorf("level %d is not between 1 and 100", level)
+	}
+	s, ok := gamedata.SpeciesByIndex(p.Species)
+	if !ok {
+		return fmt.Errorf("unknown species 0x%02X", p.Species)
+	}
+
+	return p.restat(func() {
+		p.Level = byte(level)
+		p.Exp = s.GrowthRate.ExpForLevel(level)
+	})
+}
+
+// Evolve changes the Pokémon into species into as the game does, recalculating its stats.
+// Its nickname is kept unless it was the name of its species, which changes to the new species' name.
+func (p *Pokemon) Evolve(into gamedata.Species) error {
+	from, ok := gamedata.SpeciesByIndex(p.Species)
+	if !ok {
+		return fmt.Errorf("unknown species 0x%02X", p.Species)
+	}
+
+	return p.restat(func() {
+		if p.Nickname == from.Name {
+			p.Nickname = into.Name
+		}
+		p.Species = into.Index
+		p.Type1, p.Type2 = byte(into.Types[0]), byte(into.Types[1])
+	})
+}
+
+// restat makes change, then recalculates the stats of party Pokémon, which are the only ones to store them.
+// Current HP moves by as much as the maximum did, as on level up, but stays within it and a fainted Pokémon stays fainted.
+func (p *Pokemon) restat(change func()) error {
+	before, err := p.maxHP()
+	if err != nil {
+		return err
+	}
+	change()
+	if p.Stats != (Stats{}) {
+		if err := p.UpdateStats(); err != nil {
+			return err
+		}
+	}
+	after, err := p.maxHP()
+	if err != nil {
+		return err
+	}
+
+	if p.HP == 0 {
+		return nil
+	}
+	hp := int(p.HP) + int(after) - int(before)
+	switch {
+	case hp < 1:
+		hp = 1
+	case hp > int(after):
+		hp = int(after)
+	}
+	p.HP = uint16(hp)
+	return nil
+}
+
+// maxHP returns the Pokémon's maximum HP: the one stored by party Pokémon,
+// or one calculated for Pokémon in boxes, which do not store their stats.
+func (p Pokemon) maxHP() (uint16, error) {
+	if p.Stats.HP != 0 {
+		return p.Stats.HP, nil
+	}
+	if err := p.UpdateStats(); err != nil {
+		return 0, err
+	}
+	return p.Stats.HP, nil
+}
@@ -0,0 +1,372 @@
+package save
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+	"pokegen/internal/util"
+)
+
+var ErrInvalidSize = errors.New("invalid save size")
+
+// File is a Gen 1 save file held in memory.
+type File struct {
+	data   []byte
+	layout Layout
+}
+
+// Load reads a save file using the International layout.
+// The data is copied, so later changes to data do not affect the File.
+func Load(data []byte) (*File, error) {
+	return LoadLayout(data, International)
+}
+
+// LoadLayout reads a save file using the given layout.
+func LoadLayout(data []byte, layout Layout) (*File, error) {
+	if len(data) != Size {
+		return nil, fmt.Errorf("got %d bytes, want %d: %w", len(data), Size, ErrInvalidSize)
+	}
+
+	return &File{
+		data:   append([]byte(nil), data...),
+		layout: layout,
+	}, nil
+}
+
+// Bytes returns the raw save data.
+func (f *File) Bytes() []byte {
+	return f.data
+}
+
+// Layout returns the layout used to read the save.
+func (f *File) Layout() Layout {
+	return f.layout
+}
+
+func (f *File) PlayerName() (string, error) {
+	return f.layout.Charset.ReadText(f.data[f.layout.PlayerName : f.layout.PlayerName+f.layout.NameLength])
+}
+
+func (f *File) RivalName() (string, error) {
+	return f.layout.Charset.ReadText(f.data[f.layout.RivalName : f.layout.RivalName+f.layout.NameLength])
+}
+
+func (f *File) SetPlayerName(name string) error {
+	return f.writeText(f.layout.PlayerName, name)
+}
+
+func (f *File) SetRivalName(name string) error {
+	return f.writeText(f.layout.RivalName, name)
+}
+
+// writeText writes a name at offset using the layout's character set and name length.
+func (f *File) writeText(offset int, text string) error {
+	return f.layout.writeName(f.data[offset:], text)
+}
+
+func (f *File) PlayerID() uint16 {
+	return binary.BigEndian.Uint16(f.data[f.layout.PlayerID:])
+}
+
+func (f *File) Money() (uint64, error) {
+	const moneySpace = 3
+	return util.ReadBinaryCodedDecimal(f.data[f.layout.Money : f.layout.Money+moneySpace])
+}
+
+func (f *File) Coins() (uint64, error) {
+	const coinsSpace = 2
+	return util.ReadBinaryCodedDecimal(f.data[f.layout.Coins : f.layout.Coins+coinsSpace])
+}
+
+// Badges returns the obtained badges as a bit field, with bit 0 being the Boulder Badge.
+func (f *File) Badges() byte {
+	return f.data[f.layout.Badges]
+}
+
+func (f *File) SetBadges(badges byte) {
+	f.data[f.layout.Badges] = badges
+}
+
+// PlayTime is the in-game play time.
+type PlayTime struct {
+	Hours   int `json:"hours"`
+	Minutes int `json:"minutes"`
+	Seconds int `json:"seconds"`
+}
+
+func (f *File) PlayTime() PlayTime {
+	// hours, maxed flag, minutes, seconds, frames
+	t := f.data[f.layout.PlayTime : f.layout.PlayTime+5]
+	return PlayTime{
+		Hours:   int(t[0]),
+		Minutes: int(t[2]),
+		Seconds: int(t[3]),
+	}
+}
+
+// Location is the map the player is on and their position within it.
+type Location struct {
+	Map byte `json:"map"`
+	X   byte `json:"x"`
+	Y   byte `json:"y"`
+}
+
+func (f *File) Location() Location {
+	return Location{
+		Map: f.data[f.layout.CurrentMap],
+		X:   f.data[f.layout.XCoord],
+		Y:   f.data[f.layout.YCoord],
+	}
+}
+
+// SetLocation moves the player. The map's own data, such as its tileset and connections, is left as it is,
+// so the player should be moved to a map the save's map data suits, or the game will load the map with the wrong data.
+func (f *File) SetLocation(l Location) {
+	f.data[f.layout.CurrentMap] = l.Map
+	f.data[f.layout.XCoord] = l.X
+	f.data[f.layout.YCoord] = l.Y
+}
+
+// PikachuFriendship returns the starter Pikachu's friendship, which is only used by Yellow.
+func (f *File) PikachuFriendship() byte {
+	return f.data[f.layout.PikachuFriendship]
+}
+
+func (f *File) SetPikachuFriendship(friendship byte) {
+	f.data[f.layout.PikachuFriendship] = friendship
+}
+
+// PikachuFollowing reports whether the starter Pikachu walks behind the player, which only Yellow does.
+func (f *File) PikachuFollowing() bool {
+	return f.data[f.layout.PikachuFollowing]&0x80 != 0
+}
+
+func (f *File) SetPikachuFollowing(following bool) {
+	if following {
+		f.data[f.layout.PikachuFollowing] |= 0x80
+	} else {
+		f.data[f.layout.PikachuFollowing] &^= 0x80
+	}
+}
+
+// PokedexOwned reports whether the Pokémon with the given Pokédex number is marked as owned.
+func (f *File) PokedexOwned(dex int) bool {
+	return f.flag(f.layout.PokedexOwned, dex-1)
+}
+
+// PokedexSeen reports whether the Pokémon with the given Pokédex number is marked as seen.
+func (f *File) PokedexSeen(dex int) bool {
+	return f.flag(f.layout.PokedexSeen, dex-1)
+}
+
+// PokedexCounts returns the number of Pokémon marked as owned and seen.
+func (f *File) PokedexCounts() (owned, seen int) {
+	const pokedexBytes = (pokedexSize + 7) / 8
+	for i := 0; i < pokedexBytes; i++ {
+		owned += bits.OnesCount8(f.data[f.layout.PokedexOwned+i])
+		seen += bits.OnesCount8(f.data[f.layout.PokedexSeen+i])
+	}
+	return owned, seen
+}
+
+// SetPokedexOwned marks the Pokémon with the given Pokédex number as owned or not.
+func (f *File) SetPokedexOwned(dex int, owned bool) {
+	f.setFlag(f.layout.PokedexOwned, dex-1, owned)
+}
+
+// SetPokedexSeen marks the Pokémon with the given Pokédex number as seen or not.
+func (f *File) SetPokedexSeen(dex int, seen bool) {
+	f.setFlag(f.layout.PokedexSeen, dex-1, seen)
+}
+
+// EventFlag reports whether event flag n is set.
+func (f *File) EventFlag(n int) bool {
+	return f.flag(f.layout.EventFlags, n)
+}
+
+func (f *File) SetEventFlag(n int, set bool) {
+	f.setFlag(f.layout.EventFlags, n, set)
+}
+
+// InGameTradeDone reports whether in-game trade n, numbered in the order of the game's trade table, has been made.
+func (f *File) InGameTradeDone(n int) bool {
+	return f.flag(f.layout.InGameTrades, n)
+}
+
+func (f *File) SetInGameTradeDone(n int, done bool) {
+	f.setFlag(f.layout.InGameTrades, n, done)
+}
+
+// MissableObjectHidden reports whether missable object n, such as an item ball or a person who leaves, is hidden.
+func (f *File) MissableObjectHidden(n int) bool {
+	return f.flag(f.layout.MissableObjects, n)
+}
+
+func (f *File) SetMissableObjectHidden(n int, hidden bool) {
+	f.setFlag(f.layout.MissableObjects, n, hidden)
+}
+
+func (f *File) flag(offset, n int) bool {
+	return f.data[offset+n/8]&(1<<(n%8)) != 0
+}
+
+func (f *File) setFlag(offset, n int, set bool) {
+	if set {
+		f.data[offset+n/8] |= 1 << (n % 8)
+	} else {
+		f.data[offset+n/8] &^= 1 << (n % 8)
+	}
+}
+
+// PlayerStarter returns the internal species index of the starter the player chose in Oak's lab, or 0 before they have chosen.
+func (f *File) PlayerStarter() byte {
+	return f.data[f.layout.PlayerStarter]
+}
+
+func (f *File) SetPlayerStarter(species byte) {
+	f.data[f.layout.PlayerStarter] = species
+}
+
+// RivalStarter returns the internal species index of the rival's starter, which decides the rival's team in every battle.
+func (f *File) RivalStarter() byte {
+	return f.data[f.layout.RivalStarter]
+}
+
+func (f *File) SetRivalStarter(species byte) {
+	f.data[f.layout.RivalStarter] = species
+}
+
+// CurrentBox returns the zero based index of the selected PC box.
+func (f *File) CurrentBox() int {
+	return int(f.data[f.layout.CurrentBox] & 0x7F)
+}
+
+// BoxesInitialised reports whether the box banks hold valid data.
+// The game only initialises them the first time the player changes box; until then only the current box is valid.
+func (f *File) BoxesInitialised() bool {
+	return f.data[f.layout.CurrentBox]&0x80 != 0
+}
+
+// ItemStack is an item and its quantity.
+type ItemStack struct {
+	Item     byte
+	Quantity byte
+}
+
+func (f *File) Bag() ([]ItemStack, error) {
+	return f.readItems(f.layout.Bag, BagCapacity)
+}
+
+func (f *File) PCItems() ([]ItemStack, error) {
+	return f.readItems(f.layout.PCItems, PCItemsCapacity)
+}
+
+func (f *File) readItems(offset, capacity int) ([]ItemStack, error) {
+	count := int(f.data[offset])
+	if count > capacity {
+		return nil, fmt.Errorf("item count %d exceeds capacity %d", count, capacity)
+	}
+
+	items := make([]ItemStack, count)
+	for i := range items {
+		items[i] = ItemStack{
+			Item:     f.data[offset+1+i*2],
+			Quantity: f.data[offset+2+i*2],
+		}
+	}
+	return items, nil
+}
+
+func (f *File) SetBag(items []ItemStack) error {
+	return f.writeItems(f.layout.Bag, BagCapacity, items)
+}
+
+func (f *File) SetPCItems(items []ItemStack) error {
+	return f.writeItems(f.layout.PCItems, PCItemsCapacity, items)
+}
+
+func (f *File) writeItems(offset, capacity int, items []ItemStack) error {
+	if len(items) > capacity {
+		return fmt.Errorf("item count %d exceeds capacity %d", len(items), capacity)
+	}
+
+	f.data[offset] = byte(len(items))
+	for i, stack := range items {
+		f.data[offset+1+i*2] = stack.Item
+		f.data[offset+2+i*2] = stack.Quantity
+	}
+	f.data[offset+1+len(items)*2] = 0xFF
+	return nil
+}
+
+func (f *File) Party() ([]Pokemon, error) {
+	return f.readPokemonList(f.layout.Party, partyCapacity, partyPokemonSize)
+}
+
+// SetParty replaces the party, writing names using the layout's character set.
+func (f *File) SetParty(party []Pokemon) error {
+	return f.writePokemonList(f.layout.Party, partyCapacity, partyPokemonSize, party)
+}
+
+// Box returns the Pokémon in box n (zero based).
+// The current box is read from the main data, where the game keeps its working copy.
+func (f *File) Box(n int) ([]Pokemon, error) {
+	if n < 0 || n >= f.layout.Boxes {
+		return nil, fmt.Errorf("box %d does not exist", n+1)
+	}
+
+	if n == f.CurrentBox() {
+		return f.readPokemonList(f.layout.BoxData, f.layout.BoxCapacity, boxPokemonSize)
+	}
+
+	if !f.BoxesInitialised() {
+		return nil, nil
+	}
+
+	return f.readPokemonList(f.layout.boxOffset(n), f.layout.BoxCapacity, boxPokemonSize)
+}
+
+// SetBox replaces the Pokémon in box n (zero based), initialising the box banks first if a box other than the current one is written.
+func (f *File) SetBox(n int, box []Pokemon) error {
+	if n < 0 || n >= f.layout.Boxes {
+		return fmt.Errorf("box %d does not exist", n+1)
+	}
+
+	if n == f.CurrentBox() {
+		return f.writePokemonList(f.layout.BoxData, f.layout.BoxCapacity, boxPokemonSize, box)
+	}
+
+	if !f.BoxesInitialised() {
+		f.initialiseBoxes()
+	}
+
+	return f.writePokemonList(f.layout.boxOffset(n), f.layout.BoxCapacity, boxPokemonSize, box)
+}
+
+// initialiseBoxes empties every box in the banks and saves the current box to its place there,
+// as the game does the first time the player changes box.
+func (f *File) initialiseBoxes() {
+	empty, _ := f.layout.encodePokemonList(nil, f.layout.BoxCapacity, boxPokemonSize)
+	for n := 0; n < f.layout.Boxes; n++ {
+		copy(f.data[f.layout.boxOffset(n):], empty)
+	}
+
+	current := f.layout.boxOffset(f.CurrentBox())
+	copy(f.data[current:current+f.layout.boxSize()], f.data[f.layout.BoxData:])
+	f.data[f.layout.CurrentBox] |= 0x80
+}
+
+func (f *File) readPokemonList(offset, capacity, size int) ([]Pokemon, error) {
+	return f.layout.decodePokemonList(f.data[offset:], capacity, size)
+}
+
+// writePokemonList encodes the list before anything is written, so the save is left unchanged should it not fit.
+func (f *File) writePokemonList(offset, capacity, size int, list []Pokemon) error {
+	b, err := f.layout.encodePokemonList(list, capacity, size)
+	if err != nil {
+		return err
+	}
+	copy(f.data[offset:], b)
+	return nil
+}
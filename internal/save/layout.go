@@ -0,0 +1,178 @@
+package save
+
+import "pokegen/internal/util"
+
+// Size is the size in bytes of a Gen 1 save file.
+const Size = 0x8000
+
+const (
+	partyCapacity = 6
+	pokedexSize   = 151
+
+	boxPokemonSize   = 33
+	partyPokemonSize = 44
+)
+
+// InGameTradeCount is the number of in-game trades the save records as done.
+const InGameTradeCount = 16
+
+// MissableObjectCount is the number of missable objects the save records as hidden or shown.
+const MissableObjectCount = 0x100
+
+const (
+	// BagCapacity and PCItemsCapacity are the most item stacks the bag and the PC can hold.
+	BagCapacity     = 20
+	PCItemsCapacity = 50
+)
+
+// Layout describes the offsets of the data within a save file and the character set its text is stored in.
+type Layout struct {
+	Charset    util.Charset
+	NameLength int
+
+	PlayerName        int
+	PokedexOwned      int
+	PokedexSeen       int
+	Bag               int
+	Money             int
+	RivalName         int
+	Options           int
+	Badges            int
+	PlayerID          int
+	CurrentMap        int
+	YCoord            int
+	XCoord            int
+	PikachuFriendship int
+	PikachuFollowing  int
+	PCItems           int
+	CurrentBox        int
+	Coins             int
+	MissableObjects   int
+	RivalStarter      int
+	PlayerStarter     int
+	InGameTrades      int
+	EventFlags        int
+	PlayTime          int
+	DayCare           int
+	Party             int
+	BoxData           int
+	MainChecksum      int
+
+	Boxes        int
+	BoxCapacity  int
+	BoxesPerBank int
+}
+
+// International is the layout used by the English releases of Red, Blue and Yellow.
+var International = Layout{
+	Charset:    util.English,
+	NameLength: 11,
+
+	PlayerName:        0x2598,
+	PokedexOwned:      0x25A3,
+	PokedexSeen:       0x25B6,
+	Bag:               0x25C9,
+	Money:             0x25F3,
+	RivalName:         0x25F6,
+	Options:           0x2601,
+	Badges:            0x2602,
+	PlayerID:          0x2605,
+	CurrentMap:        0x260A,
+	YCoord:            0x260D,
+	XCoord:            0x260E,
+	PikachuFriendship: 0x271C,
+	PikachuFollowing:  0x271E,
+	PCItems:           0x27E6,
+	CurrentBox:        0x284C,
+	Coins:             0x2850,
+	MissableObjects:   0x2852,
+	RivalStarter:      0x29C1,
+	PlayerStarter:     0x29C3,
+	InGameTrades:      0x29E3,
+	EventFlags:        0x29F3,
+	PlayTime:          0x2CED,
+	DayCare:           0x2CF4,
+	Party:             0x2F2C,
+	BoxData:           0x30C0,
+	MainChecksum:      0x3523,
+
+	Boxes:        12,
+	BoxCapacity:  20,
+	BoxesPerBank: 6,
+}
+
+// Japanese is the layout used by the Japanese releases of Red, Green, Blue and Yellow.
+// Names are shorter, so everything following the player name moves, and the PC holds 8 boxes of 30.
+var Japanese = Layout{
+	Charset:    util.Japanese,
+	NameLength: 6,
+
+	PlayerName:        0x2598,
+	PokedexOwned:      0x259E,
+	PokedexSeen:       0x25B1,
+	Bag:               0x25C4,
+	Money:             0x25EE,
+	RivalName:         0x25F1,
+	Options:           0x25F7,
+	Badges:            0x25F8,
+	PlayerID:          0x25FB,
+	CurrentMap:        0x2600,
+	YCoord:            0x2603,
+	XCoord:            0x2604,
+	PikachuFriendship: 0x2712,
+	PikachuFollowing:  0x2714,
+	PCItems:           0x27DC,
+	CurrentBox:        0x2842,
+	Coins:             0x2846,
+	MissableObjects:   0x2848,
+	RivalStarter:      0x29B7,
+	PlayerStarter:     0x29B9,
+	InGameTrades:      0x29D9,
+	EventFlags:        0x29E9,
+	PlayTime:          0x2CA0,
+	DayCare:           0x2CA7,
+	Party:             0x2ED5,
+	BoxData:           0x302D,
+	MainChecksum:      0x3594,
+
+	Boxes:        8,
+	BoxCapacity:  30,
+	BoxesPerBank: 4,
+}
+
+// The European releases share the International layout but store text using their own character sets.
+var (
+	French  = International.withCharset(util.French)
+	German  = International.withCharset(util.German)
+	Italian = International.withCharset(util.Italian)
+	Spanish = International.withCharset(util.Spanish)
+)
+
+// Layouts holds the supported layouts by language code.
+var Layouts = map[string]Layout{
+	"en": International,
+	"fr": French,
+	"de": German,
+	"it": Italian,
+	"es": Spanish,
+	"ja": Japanese,
+}
+
+func (l Layout) withCharset(charset util.Charset) Layout {
+	l.Charset = charset
+	return l
+}
+
+// boxSize is the size of a single box: count, species list, Pokémon, OT names and nicknames.
+func (l Layout) boxSize() int {
+	return l.pokemonListSize(l.BoxCapacity, boxPokemonSize)
+}
+
+// boxOffset returns the offset of box n (zero based) within the box banks.
+func (l Layout) boxOffset(n int) int {
+	const bank2, bank3 = 0x4000, 0x6000
+	if n < l.BoxesPerBank {
+		return bank2 + n*l.boxSize()
+	}
+	return bank3 + (n-l.BoxesPerBank)*l.boxSize()
+}
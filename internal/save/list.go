@@ -0,0 +1,90 @@
+package save
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Lists of Pokémon, such as the party and each box, are stored as a count, a species list ended by 0xFF,
+// the Pokémon data, OT names and then nicknames, with room for capacity Pokémon in each part.
+
+// pokemonListSize returns the size of a list with room for capacity Pokémon of size bytes each.
+func (l Layout) pokemonListSize(capacity, size int) int {
+	return 1 + capacity + 1 + capacity*(size+2*l.NameLength)
+}
+
+// decodePokemonList decodes a list of Pokémon from the start of b.
+func (l Layout) decodePokemonList(b []byte, capacity, size int) ([]Pokemon, error) {
+	if len(b) < l.pokemonListSize(capacity, size) {
+		return nil, fmt.Errorf("got %d bytes, want %d", len(b), l.pokemonListSize(capacity, size))
+	}
+
+	count := int(b[0])
+	if count > capacity {
+		return nil, fmt.Errorf("pokémon count %d exceeds capacity %d", count, capacity)
+	}
+
+	dataOffset := 1 + capacity + 1
+	otNamesOffset := dataOffset + capacity*size
+	nicknamesOffset := otNamesOffset + capacity*l.NameLength
+
+	list := make([]Pokemon, count)
+	for i := range list {
+		p := decodePokemon(b[dataOffset+i*size : dataOffset+(i+1)*size])
+
+		var err error
+		nameOffset := i * l.NameLength
+		p.OTName, err = l.Charset.ReadText(b[otNamesOffset+nameOffset : otNamesOffset+nameOffset+l.NameLength])
+		if err != nil {
+			return nil, fmt.Errorf("pokémon %d OT name: %w", i+1, err)
+		}
+		p.Nickname, err = l.Charset.ReadText(b[nicknamesOffset+nameOffset : nicknamesOffset+nameOffset+l.NameLength])
+		if err != nil {
+			return nil, fmt.Errorf("pokémon %d nickname: %w", i+1, err)
+		}
+
+		list[i] = p
+	}
+	return list, nil
+}
+
+// encodePokemonList encodes a list of Pokémon, writing names using the layout's character set.
+// Unused slots are zeroed.
+func (l Layout) encodePokemonList(list []Pokemon, capacity, size int) ([]byte, error) {
+	if len(list) > capacity {
+		return nil, fmt.Errorf("%d pokémon exceeds capacity %d", len(list), capacity)
+	}
+
+	b := make([]byte, l.pokemonListSize(capacity, size))
+	b[0] = byte(len(list))
+	b[1+len(list)] = 0xFF
+
+	dataOffset := 1 + capacity + 1
+	otNamesOffset := dataOffset + capacity*size
+	nicknamesOffset := otNamesOffset + capacity*l.NameLength
+
+	for i, p := range list {
+		b[1+i] = p.Species
+		copy(b[dataOffset+i*size:], encodePokemon(p, size))
+
+		nameOffset := i * l.NameLength
+		if err := l.writeName(b[otNamesOffset+nameOffset:], p.OTName); err != nil {
+			return nil, fmt.Errorf("pokémon %d OT name: %w", i+1, err)
+		}
+		if err := l.writeName(b[nicknamesOffset+nameOffset:], p.Nickname); err != nil {
+			return nil, fmt.Errorf("pokémon %d nickname: %w", i+1, err)
+		}
+	}
+
+	return b, nil
+}
+
+// writeName writes a name to the start of b using the layout's character set and name length.
+func (l Layout) writeName(b []byte, name string) error {
+	var buf bytes.Buffer
+	if err := l.Charset.WriteText(&buf, name, l.NameLength); err != nil {
+		return err
+	}
+	copy(b, buf.Bytes())
+	return nil
+}
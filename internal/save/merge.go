@@ -0,0 +1,192 @@
+package save
+
+import (
+	"fmt"
+	"pokegen/internal/gamedata"
+	"strconv"
+	"strings"
+)
+
+// Regions of a save that Merge can take from another save.
+const (
+	// RegionTrainer is the player's and rival's names, the player's ID, money, coins and play time.
+	RegionTrainer = "trainer"
+	// RegionProgress is the badges, event flags, hidden and shown objects, map script states and the player's location,
+	// along with the data of the map they are on. In Yellow the map data holds Pikachu's friendship too.
+	RegionProgress = "progress"
+	RegionPokedex  = "pokedex"
+	RegionParty    = "party"
+	// RegionBoxes is every PC box; a single box is taken with a region such as box:3.
+	RegionBoxes   = "boxes"
+	RegionBox     = "box"
+	RegionBag     = "bag"
+	RegionPCItems = "pc_items"
+	RegionDayCare = "daycare"
+)
+
+// Region is an area of a save that Merge can take from another save.
+// Box is zero based, and only used by RegionBox.
+type Region struct {
+	Name string
+	Box  int
+}
+
+// ParseRegion parses a region name such as "progress", or "box:3" for a single box numbered from 1.
+func ParseRegion(s string) (Region, error) {
+	switch s {
+	case RegionTrainer, RegionProgress, RegionPokedex, RegionParty, RegionBoxes, RegionBag, RegionPCItems, RegionDayCare:
+		return Region{Name: s}, nil
+	}
+
+	if strings.HasPrefix(s, RegionBox+":") {
+		box := strings.TrimPrefix(s, RegionBox+":")
+		n, err := strconv.Atoi(box)
+		if err != nil || n < 1 {
+			return Region{}, fmt.Errorf("region %q: %q is not a positive number", s, box)
+		}
+		return Region{Name: RegionBox, Box: n - 1}, nil
+	}
+
+	return Region{}, fmt.Errorf("unknown region %q, want %s, %s, %s, %s, %s, box:<box>, %s, %s or %s",
+		s, RegionTrainer, RegionProgress, RegionPokedex, RegionParty, RegionBoxes, RegionBag, RegionPCItems, RegionDayCare)
+}
+
+func (r Region) String() string {
+	if r.Name == RegionBox {
+		return fmt.Sprintf("%s:%d", RegionBox, r.Box+1)
+	}
+	return r.Name
+}
+
+// Take is a directive to take a region from another save.
+type Take struct {
+	Region Region
+	From   *File
+}
+
+// Merge copies each region taken from another save into f, in order, so a region taken twice comes from the last save it is taken from.
+// The save is then reconciled, so the fields derived from the regions taken agree with them, and the fixes made are returned.
+// Regions copied byte for byte can only be taken from saves in a layout with the same name length as f's.
+func (f *File) Merge(takes []Take) ([]Fix, error) {
+	for _, t := range takes {
+		if err := f.take(t.Region, t.From); err != nil {
+			return nil, fmt.Errorf("%s: %w", t.Region, err)
+		}
+	}
+	return f.Reconcile()
+}
+
+func (f *File) take(r Region, from *File) error {
+	switch r.Name {
+	case RegionTrainer:
+		return f.takeTrainer(from)
+
+	case RegionProgress:
+		if err := f.sameNameLength(from); err != nil {
+			return err
+		}
+		l := f.layout
+		f.SetBadges(from.Badges())
+		// The current map is followed by the data the game loads it with, up to the PC items.
+		f.copyBytes(from, l.CurrentMap, l.PCItems-l.CurrentMap)
+		// The hidden and shown objects are followed by the map scripts' states and other story state, up to the end of the event flags.
+		f.copyBytes(from, l.MissableObjects, l.EventFlags+gamedata.EventFlagCount/8-l.MissableObjects)
+		return nil
+
+	case RegionPokedex:
+		for dex := 1; dex <= pokedexSize; dex++ {
+			f.SetPokedexOwned(dex, from.PokedexOwned(dex))
+			f.SetPokedexSeen(dex, from.PokedexSeen(dex))
+		}
+		return nil
+
+	case RegionParty:
+		party, err := from.Party()
+		if err != nil {
+			return err
+		}
+		return f.SetParty(party)
+
+	case RegionBoxes:
+		if f.layout.Boxes != from.layout.Boxes {
+			return fmt.Errorf("cannot take %d boxes into a save with %d", from.layout.Boxes, f.layout.Boxes)
+		}
+		for n := 0; n < f.layout.Boxes; n++ {
+			if err := f.takeBox(n, from); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case RegionBox:
+		return f.takeBox(r.Box, from)
+
+	case RegionBag:
+		bag, err := from.Bag()
+		if err != nil {
+			return err
+		}
+		return f.SetBag(bag)
+
+	case RegionPCItems:
+		items, err := from.PCItems()
+		if err != nil {
+			return err
+		}
+		return f.SetPCItems(items)
+
+	case RegionDayCare:
+		if err := f.sameNameLength(from); err != nil {
+			return err
+		}
+		// In use flag, nickname, OT name and the Pokémon as stored in a box.
+		f.copyBytes(from, f.layout.DayCare, 1+2*f.layout.NameLength+boxPokemonSize)
+		return nil
+	}
+
+	return fmt.Errorf("unknown region")
+}
+
+func (f *File) takeTrainer(from *File) error {
+	playerName, err := from.PlayerName()
+	if err != nil {
+		return fmt.Errorf("player name: %w", err)
+	}
+	if err := f.SetPlayerName(playerName); err != nil {
+		return fmt.Errorf("player name: %w", err)
+	}
+	rivalName, err := from.RivalName()
+	if err != nil {
+		return fmt.Errorf("rival name: %w", err)
+	}
+	if err := f.SetRivalName(rivalName); err != nil {
+		return fmt.Errorf("rival name: %w", err)
+	}
+
+	const idSize, moneySize, coinsSize, playTimeSize = 2, 3, 2, 5
+	f.copyBytes(from, f.layout.PlayerID, idSize)
+	f.copyBytes(from, f.layout.Money, moneySize)
+	f.copyBytes(from, f.layout.Coins, coinsSize)
+	f.copyBytes(from, f.layout.PlayTime, playTimeSize)
+	return nil
+}
+
+func (f *File) takeBox(n int, from *File) error {
+	box, err := from.Box(n)
+	if err != nil {
+		return err
+	}
+	return f.SetBox(n, box)
+}
+
+// copyBytes copies length bytes at offset from the save from, whose layout must match f's there.
+func (f *File) copyBytes(from *File, offset, length int) {
+	copy(f.data[offset:offset+length], from.data[offset:offset+length])
+}
+
+func (f *File) sameNameLength(from *File) error {
+	if f.layout.NameLength != from.layout.NameLength {
+		return fmt.Errorf("cannot be taken from a save with %d character names into one with %d", from.layout.NameLength, f.layout.NameLength)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package save
+
+import (
+	"fmt"
+	"pokegen/internal/gamedata"
+	"sort"
+)
+
+// Orders sort can put Pokémon in.
+const (
+	SortDex   = "dex"
+	SortLevel = "level"
+	SortName  = "name"
+)
+
+// sortBoxes sorts the Pokémon in box, numbered from 1, or when box is 0 every Pokémon in the boxes together,
+// refilling the boxes from the first. Pokémon that compare equal keep their order.
+func (f *File) sortBoxes(by string, box int) error {
+	var less func(a, b Pokemon) bool
+	switch by {
+	case SortDex:
+		less = func(a, b Pokemon) bool { return dex(a.Species) < dex(b.Species) }
+	case SortLevel:
+		less = func(a, b Pokemon) bool { return a.Level < b.Level }
+	case SortName:
+		less = func(a, b Pokemon) bool { return a.Nickname < b.Nickname }
+	default:
+		return fmt.Errorf("unknown order %q, want %s, %s or %s", by, SortDex, SortLevel, SortName)
+	}
+
+	boxes := make([]int, 0, f.layout.Boxes)
+	if box != 0 {
+		if box < 1 || box > f.layout.Boxes {
+			return fmt.Errorf("box %d does not exist", box)
+		}
+		boxes = append(boxes, box-1)
+	} else {
+		for n := 0; n < f.layout.Boxes; n++ {
+			boxes = append(boxes, n)
+		}
+	}
+
+	var all []Pokemon
+	for _, n := range boxes {
+		list, err := f.Box(n)
+		if err != nil {
+			return fmt.Errorf("box %d: %w", n+1, err)
+		}
+		all = append(all, list...)
+	}
+	sort.SliceStable(all, func(i, j int) bool { return less(all[i], all[j]) })
+
+	for _, n := range boxes {
+		count := len(all)
+		if count > f.layout.BoxCapacity {
+			count = f.layout.BoxCapacity
+		}
+		list := all[:count]
+		all = all[count:]
+
+		if len(list) == 0 && n != f.CurrentBox() && !f.BoxesInitialised() {
+			// Empty boxes that have never been saved to need no writing.
+			continue
+		}
+		if err := f.SetBox(n, list); err != nil {
+			return fmt.Errorf("box %d: %w", n+1, err)
+		}
+	}
+	return nil
+}
+
+// dex returns the Pokédex number of the species with the given index, or 0 for unknown species, sorting them first.
+func dex(index byte) int {
+	s, _ := gamedata.SpeciesByIndex(index)
+	return s.Dex
+}
+
+// move moves the Pokémon in slot from to slot to, as depositing or withdrawing it in the game would.
+// Pokémon withdrawn into the party have their stats calculated, and those deposited lose them.
+func (f *File) move(from, to string) error {
+	if from == "" || to == "" {
+		return fmt.Errorf("move needs the slot of the pokémon to move and the slot to move it to")
+	}
+	src, err := ParseSlot(from)
+	if err != nil {
+		return err
+	}
+	dst, err := ParseSlot(to)
+	if err != nil {
+		return err
+	}
+
+	p, err := f.Pokemon(src)
+	if err != nil {
+		return err
+	}
+	if err := f.remove(src); err != nil {
+		return err
+	}
+
+	switch {
+	case dst.Party && !src.Party:
+		if err := p.UpdateStats(); err != nil {
+			return err
+		}
+	case !dst.Party:
+		p.Stats = Stats{}
+	}
+
+	list, err := f.list(dst)
+	if err != nil {
+		return err
+	}
+	if dst.Index > len(list) {
+		return fmt.Errorf("slot %s is after the first empty slot %d", dst, len(list)+1)
+	}
+	list = append(list[:dst.Index], append([]Pokemon{p}, list[dst.Index:]...)...)
+	if err := f.setList(dst, list); err != nil {
+		return fmt.Errorf("slot %s: %w", dst, err)
+	}
+	return nil
+}
+
+// release releases the Pokémon in slot, moving those after it down a place.
+func (f *File) release(slot string) error {
+	if slot == "" {
+		return fmt.Errorf("release needs the slot of the pokémon to release")
+	}
+	s, err := ParseSlot(slot)
+	if err != nil {
+		return err
+	}
+	return f.remove(s)
+}
+
+// remove takes the Pokémon in slot s out of its party or box. As in the game, the party cannot be left empty.
+func (f *File) remove(s Slot) error {
+	list, err := f.list(s)
+	if err != nil {
+		return err
+	}
+	if s.Index < 0 || s.Index >= len(list) {
+		return fmt.Errorf("slot %s is empty", s)
+	}
+	if s.Party && len(list) == 1 {
+		return fmt.Errorf("the party cannot be left empty")
+	}
+	return f.setList(s, append(list[:s.Index], list[s.Index+1:]...))
+}
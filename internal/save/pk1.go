@@ -0,0 +1,59 @@
+package save
+
+import (
+	"errors"
+	"fmt"
+)
+
+// .pk1 files hold a single Pokémon as used by community save editors.
+// They are laid out as a party with room for one Pokémon, so their size depends on the layout's name length.
+
+var ErrInvalidPK1 = errors.New("invalid pk1 file")
+
+// EncodePK1 encodes p as a .pk1 file, writing names using the layout's character set.
+func EncodePK1(p Pokemon, layout Layout) ([]byte, error) {
+	return layout.encodePokemonList([]Pokemon{p}, 1, partyPokemonSize)
+}
+
+// DecodePK1 decodes a .pk1 file, reading names using the layout's character set.
+func DecodePK1(data []byte, layout Layout) (Pokemon, error) {
+	if want := layout.pokemonListSize(1, partyPokemonSize); len(data) != want {
+		return Pokemon{}, fmt.Errorf("got %d bytes, want %d: %w", len(data), want, ErrInvalidPK1)
+	}
+
+	list, err := layout.decodePokemonList(data, 1, partyPokemonSize)
+	if err != nil {
+		return Pokemon{}, fmt.Errorf("%v: %w", err, ErrInvalidPK1)
+	}
+	if len(list) != 1 {
+		return Pokemon{}, fmt.Errorf("holds %d pokémon, want 1: %w", len(list), ErrInvalidPK1)
+	}
+
+	return list[0], nil
+}
+
+// ExportPK1 encodes the Pokémon in slot as a .pk1 file.
+// Pokémon in boxes have no stats stored, so they are calculated as the game does when withdrawing them.
+func (f *File) ExportPK1(s Slot) ([]byte, error) {
+	p, err := f.Pokemon(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.Party {
+		if err := p.UpdateStats(); err != nil {
+			return nil, fmt.Errorf("slot %s: %w", s, err)
+		}
+	}
+
+	return EncodePK1(p, f.layout)
+}
+
+// ImportPK1 decodes a .pk1 file into slot, as with SetPokemon.
+func (f *File) ImportPK1(s Slot, data []byte) error {
+	p, err := DecodePK1(data, f.layout)
+	if err != nil {
+		return err
+	}
+	return f.SetPokemon(s, p)
+}
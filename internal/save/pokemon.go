@@ -0,0 +1,156 @@
+package save
+
+import (
+	"encoding/binary"
+	"fmt"
+	"pokegen/internal/gamedata"
+)
+
+// Stats holds a value for each of the five Gen 1 stats.
+type Stats struct {
+	HP      uint16 `json:"hp"`
+	Attack  uint16 `json:"attack"`
+	Defense uint16 `json:"defense"`
+	Speed   uint16 `json:"speed"`
+	Special uint16 `json:"special"`
+}
+
+// Pokemon is a Pokémon as stored in the party or a PC box.
+// Stats is only stored for party Pokémon and is zero for Pokémon in a box.
+type Pokemon struct {
+	Species   byte
+	HP        uint16
+	Level     byte
+	Status    byte
+	Type1     byte
+	Type2     byte
+	CatchRate byte
+	Moves     [4]byte
+	OTID      uint16
+	Exp       uint32
+	StatExp   Stats
+	DVs       uint16
+	PP        [4]byte
+	Stats     Stats
+
+	OTName   string
+	Nickname string
+}
+
+// DV returns the determinant values for each stat.
+// The HP DV is not stored; it is made up of the lowest bit of each of the other DVs.
+func (p Pokemon) DV() Stats {
+	dvs := Stats{
+		Attack:  p.DVs >> 12 & 0xF,
+		Defense: p.DVs >> 8 & 0xF,
+		Speed:   p.DVs >> 4 & 0xF,
+		Special: p.DVs & 0xF,
+	}
+	dvs.HP = dvs.Attack&1<<3 | dvs.Defense&1<<2 | dvs.Speed&1<<1 | dvs.Special&1
+	return dvs
+}
+
+// PackDVs packs the Attack, Defense, Speed and Special determinant values into the form they are stored in.
+// The HP DV of dvs is ignored as it is derived from the others.
+func PackDVs(dvs Stats) uint16 {
+	return dvs.Attack&0xF<<12 | dvs.Defense&0xF<<8 | dvs.Speed&0xF<<4 | dvs.Special&0xF
+}
+
+// UpdateStats recalculates the Pokémon's stats from its species, level, DVs and stat experience, as the game does on level up.
+func (p *Pokemon) UpdateStats() error {
+	s, ok := gamedata.SpeciesByIndex(p.Species)
+	if !ok {
+		return fmt.Errorf("unknown species 0x%02X", p.Species)
+	}
+
+	dvs := p.DV()
+	level := int(p.Level)
+	p.Stats = Stats{
+		HP:      calcStat(s.BaseStats.HP, dvs.HP, p.StatExp.HP, level) + uint16(level) + 5,
+		Attack:  calcStat(s.BaseStats.Attack, dvs.Attack, p.StatExp.Attack, level),
+		Defense: calcStat(s.BaseStats.Defense, dvs.Defense, p.StatExp.Defense, level),
+		Speed:   calcStat(s.BaseStats.Speed, dvs.Speed, p.StatExp.Speed, level),
+		Special: calcStat(s.BaseStats.Special, dvs.Special, p.StatExp.Special, level),
+	}
+	return nil
+}
+
+// calcStat is the Gen 1 stat formula, less the HP bonus: ((base + DV) * 2 + ceil(sqrt(stat exp)) / 4) * level / 100 + 5.
+func calcStat(base int, dv, statExp uint16, level int) uint16 {
+	bonus := 0
+	for bonus < 255 && bonus*bonus < int(statExp) {
+		bonus++
+	}
+	return uint16(((base+int(dv))*2+bonus/4)*level/100 + 5)
+}
+
+// encodePokemon encodes the 33 byte box structure, or the 44 byte party structure when size allows.
+func encodePokemon(p Pokemon, size int) []byte {
+	b := make([]byte, size)
+	b[0] = p.Species
+	binary.BigEndian.PutUint16(b[1:], p.HP)
+	b[3] = p.Level
+	b[4] = p.Status
+	b[5] = p.Type1
+	b[6] = p.Type2
+	b[7] = p.CatchRate
+	copy(b[8:12], p.Moves[:])
+	binary.BigEndian.PutUint16(b[12:], p.OTID)
+	b[14], b[15], b[16] = byte(p.Exp>>16), byte(p.Exp>>8), byte(p.Exp)
+	binary.BigEndian.PutUint16(b[17:], p.StatExp.HP)
+	binary.BigEndian.PutUint16(b[19:], p.StatExp.Attack)
+	binary.BigEndian.PutUint16(b[21:], p.StatExp.Defense)
+	binary.BigEndian.PutUint16(b[23:], p.StatExp.Speed)
+	binary.BigEndian.PutUint16(b[25:], p.StatExp.Special)
+	binary.BigEndian.PutUint16(b[27:], p.DVs)
+	copy(b[29:33], p.PP[:])
+
+	if size >= partyPokemonSize {
+		b[33] = p.Level
+		binary.BigEndian.PutUint16(b[34:], p.Stats.HP)
+		binary.BigEndian.PutUint16(b[36:], p.Stats.Attack)
+		binary.BigEndian.PutUint16(b[38:], p.Stats.Defense)
+		binary.BigEndian.PutUint16(b[40:], p.Stats.Speed)
+		binary.BigEndian.PutUint16(b[42:], p.Stats.Special)
+	}
+
+	return b
+}
+
+// decodePokemon decodes the 33 byte box structure, or the 44 byte party structure.
+func decodePokemon(b []byte) Pokemon {
+	p := Pokemon{
+		Species:   b[0],
+		HP:        binary.BigEndian.Uint16(b[1:]),
+		Level:     b[3],
+		Status:    b[4],
+		Type1:     b[5],
+		Type2:     b[6],
+		CatchRate: b[7],
+		Moves:     [4]byte{b[8], b[9], b[10], b[11]},
+		OTID:      binary.BigEndian.Uint16(b[12:]),
+		Exp:       uint32(b[14])<<16 | uint32(b[15])<<8 | uint32(b[16]),
+		StatExp: Stats{
+			HP:      binary.BigEndian.Uint16(b[17:]),
+			Attack:  binary.BigEndian.Uint16(b[19:]),
+			Defense: binary.BigEndian.Uint16(b[21:]),
+			Speed:   binary.BigEndian.Uint16(b[23:]),
+			Special: binary.BigEndian.Uint16(b[25:]),
+		},
+		DVs: binary.BigEndian.Uint16(b[27:]),
+		PP:  [4]byte{b[29], b[30], b[31], b[32]},
+	}
+
+	if len(b) >= partyPokemonSize {
+		p.Level = b[33]
+		p.Stats = Stats{
+			HP:      binary.BigEndian.Uint16(b[34:]),
+			Attack:  binary.BigEndian.Uint16(b[36:]),
+			Defense: binary.BigEndian.Uint16(b[38:]),
+			Speed:   binary.BigEndian.Uint16(b[40:]),
+			Special: binary.BigEndian.Uint16(b[42:]),
+		}
+	}
+
+	return p
+}
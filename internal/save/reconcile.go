@@ -0,0 +1,194 @@
+package save
+
+import (
+	"bytes"
+	"fmt"
+	"pokegen/internal/gamedata"
+)
+
+// Fix is a change Reconcile made to bring a field into line with the data it is derived from.
+type Fix struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+func (f Fix) String() string {
+	return fmt.Sprintf("%s: %s", f.Field, f.Message)
+}
+
+// keyItem is a key item whose event flag is set when it is received,
+// and the missable object removed from the map with it, if any.
+type keyItem struct {
+	item     byte
+	event    string
+	missable int
+	// hidden describes the missable object being hidden.
+	hidden string
+}
+
+// keyItems lists the key items whose events pokegen knows; other key items are left as they are.
+var keyItems = []keyItem{
+	{item: 0x05, event: "GOT_TOWN_MAP", missable: 0x29, hidden: "hid the town map in the rival's house"},
+	{item: 0x06, event: "GOT_BICYCLE", missable: -1},
+	{item: 0x46, event: "GOT_OAKS_PARCEL", missable: -1},
+}
+
+// Reconcile brings fields that the game keeps in step with each other into agreement, returning what it changed:
+//   - the species lists of the party and boxes match their Pokémon,
+//   - the current box's place in the banks holds the same Pokémon as the working copy,
+//   - every Pokémon in the party, boxes or day care is marked as owned and seen in the Pokédex, and
+//   - the Town Map, Bicycle and Oak's Parcel have the events of receiving them set when they are in the bag or PC.
+//     Other key items are left as they are, as pokegen does not know their events.
+//
+// Problems that cannot be fixed without guessing, such as a list holding more Pokémon than its capacity, are returned as an error.
+func (f *File) Reconcile() ([]Fix, error) {
+	r := &reconciler{f: f}
+	l := f.layout
+
+	r.speciesList(l.Party, partyCapacity, partyPokemonSize, "party")
+	r.speciesList(l.BoxData, l.BoxCapacity, boxPokemonSize, "current box")
+	if f.BoxesInitialised() {
+		for n := 0; n < l.Boxes; n++ {
+			r.speciesList(l.boxOffset(n), l.BoxCapacity, boxPokemonSize, fmt.Sprintf("box %d", n+1))
+		}
+		r.currentBox()
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	if err := r.pokedex(); err != nil {
+		return nil, err
+	}
+	if err := r.keyItems(); err != nil {
+		return nil, err
+	}
+
+	return r.fixes, nil
+}
+
+type reconciler struct {
+	f     *File
+	fixes []Fix
+	err   error
+}
+
+func (r *reconciler) fixf(field, format string, args ...any) {
+	r.fixes = append(r.fixes, Fix{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+// speciesList rewrites the species list of a Pokémon list from the species stored in each Pokémon's data.
+func (r *reconciler) speciesList(offset, capacity, size int, field string) {
+	if r.err != nil {
+		return
+	}
+
+	count := int(r.f.data[offset])
+	if count > capacity {
+		r.err = fmt.Errorf("%s: count %d exceeds capacity %d", field, count, capacity)
+		return
+	}
+
+	data := offset + 1 + capacity + 1
+	for i := 0; i < count; i++ {
+		species := r.f.data[data+i*size]
+		if r.f.data[offset+1+i] != species {
+			r.fixf(field, "species list entry %d changed from 0x%02X to 0x%02X to match its pokémon", i+1, r.f.data[offset+1+i], species)
+			r.f.data[offset+1+i] = species
+		}
+	}
+	if r.f.data[offset+1+count] != 0xFF {
+		r.fixf(field, "species list terminated after %d pokémon", count)
+		r.f.data[offset+1+count] = 0xFF
+	}
+}
+
+// currentBox copies the working copy of the current box to its place in the banks.
+func (r *reconciler) currentBox() {
+	l := r.f.layout
+	bank := r.f.data[l.boxOffset(r.f.CurrentBox()):][:l.boxSize()]
+	working := r.f.data[l.BoxData:][:l.boxSize()]
+	if !bytes.Equal(bank, working) {
+		copy(bank, working)
+		r.fixf(fmt.Sprintf("box %d", r.f.CurrentBox()+1), "copied from the current box")
+	}
+}
+
+func (r *reconciler) pokedex() error {
+	var held []byte
+
+	party, err := r.f.Party()
+	if err != nil {
+		return fmt.Errorf("party: %w", err)
+	}
+	for _, p := range party {
+		held = append(held, p.Species)
+	}
+
+	for n := 0; n < r.f.layout.Boxes; n++ {
+		box, err := r.f.Box(n)
+		if err != nil {
+			return fmt.Errorf("box %d: %w", n+1, err)
+		}
+		for _, p := range box {
+			held = append(held, p.Species)
+		}
+	}
+
+	if dayCare := r.f.layout.DayCare; r.f.data[dayCare] != 0 {
+		held = append(held, r.f.data[dayCare+1+2*r.f.layout.NameLength])
+	}
+
+	for _, index := range held {
+		s, ok := gamedata.SpeciesByIndex(index)
+		if !ok {
+			continue
+		}
+		if !r.f.PokedexOwned(s.Dex) {
+			r.f.SetPokedexOwned(s.Dex, true)
+			r.fixf("pokedex", "marked %s as owned", s.Name)
+		}
+		if !r.f.PokedexSeen(s.Dex) {
+			r.f.SetPokedexSeen(s.Dex, true)
+			r.fixf("pokedex", "marked %s as seen", s.Name)
+		}
+	}
+	return nil
+}
+
+func (r *reconciler) keyItems() error {
+	bag, err := r.f.Bag()
+	if err != nil {
+		return fmt.Errorf("bag: %w", err)
+	}
+	pc, err := r.f.PCItems()
+	if err != nil {
+		return fmt.Errorf("pc items: %w", err)
+	}
+
+	held := map[byte]bool{}
+	for _, stack := range append(bag, pc...) {
+		held[stack.Item] = true
+	}
+
+	for _, k := range keyItems {
+		if !held[k.item] {
+			continue
+		}
+		r.event(k.event)
+		if k.missable >= 0 && !r.f.MissableObjectHidden(k.missable) {
+			r.f.SetMissableObjectHidden(k.missable, true)
+			r.fixf("missable objects", "%s", k.hidden)
+		}
+	}
+	return nil
+}
+
+func (r *reconciler) event(name string) {
+	n, ok := gamedata.EventFlagByName(name)
+	if !ok || r.f.EventFlag(n) {
+		return
+	}
+	r.f.SetEventFlag(n, true)
+	r.fixf("event flags", "set %s", name)
+}
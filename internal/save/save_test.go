@@ -0,0 +1,669 @@
+package save_test
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"pokegen/internal/gamedata"
+	"pokegen/internal/legality"
+	"pokegen/internal/pokegen"
+	"pokegen/internal/save"
+	"testing"
+)
+
+func generate(t *testing.T) []byte {
+	buf := new(bytes.Buffer)
+	err := pokegen.Gen(buf, pokegen.Options{
+		Game:       pokegen.GameRed,
+		PlayerName: "RED",
+		RivalName:  "BLUE",
+		Money:      3000,
+	})
+	assert.NoError(t, err)
+	return buf.Bytes()
+}
+
+func TestLoad_InvalidSize(t *testing.T) {
+	_, err := save.Load(make([]byte, 100))
+	assert.ErrorIs(t, err, save.ErrInvalidSize)
+}
+
+func TestSummary_GeneratedSave(t *testing.T) {
+	f, err := save.Load(generate(t))
+	assert.NoError(t, err)
+
+	s, err := f.Summary()
+	assert.NoError(t, err)
+
+	assert.Equal(t, "RED", s.PlayerName)
+	assert.Equal(t, "BLUE", s.RivalName)
+	assert.Equal(t, uint64(3000), s.Money)
+	assert.Equal(t, uint16(0xC0B2), s.PlayerID)
+	assert.Equal(t, "RED'S HOUSE 2F", s.Location.Name)
+	assert.Empty(t, s.Party)
+	assert.Len(t, s.Boxes, 12)
+	assert.Equal(t, []save.ItemSummary{{Name: "POTION", Quantity: 1}}, s.PCItems)
+	assert.True(t, s.Checksums[0].Valid())
+}
+
+func TestSummary_JapaneseSave(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := pokegen.Gen(buf, pokegen.Options{
+		Game:     pokegen.GameRed,
+		Language: pokegen.LanguageJapanese,
+		Money:    3000,
+	})
+	assert.NoError(t, err)
+
+	f, err := save.LoadLayout(buf.Bytes(), save.Japanese)
+	assert.NoError(t, err)
+
+	s, err := f.Summary()
+	assert.NoError(t, err)
+
+	assert.Equal(t, "レッド", s.PlayerName)
+	assert.Equal(t, "グリーン", s.RivalName)
+	assert.Equal(t, uint64(3000), s.Money)
+	assert.Equal(t, uint16(0xC0B2), s.PlayerID)
+	assert.Equal(t, "RED'S HOUSE 2F", s.Location.Name)
+	assert.Len(t, s.Boxes, 8)
+	assert.Equal(t, []save.ItemSummary{{Name: "POTION", Quantity: 1}}, s.PCItems)
+	assert.True(t, s.Checksums[0].Valid())
+	assert.Empty(t, save.Diagnose(f))
+}
+
+func TestConvert_NameTooLong(t *testing.T) {
+	f, err := save.Load(generate(t))
+	assert.NoError(t, err)
+
+	_, err = f.Convert(save.Japanese)
+	assert.Error(t, err, "english names cannot be written with the japanese character set")
+}
+
+func TestSummary_ChecksumMismatch(t *testing.T) {
+	data := generate(t)
+	data[save.International.Money] = 0x99
+
+	f, err := save.Load(data)
+	assert.NoError(t, err)
+
+	s, err := f.Summary()
+	assert.NoError(t, err)
+	assert.False(t, s.Checksums[0].Valid())
+}
+
+func TestParty(t *testing.T) {
+	data := generate(t)
+	party := data[save.International.Party:]
+	party[0], party[1], party[2] = 1, 0x54, 0xFF
+
+	pikachu := party[8:]
+	copy(pikachu, []byte{
+		0x54, 0x00, 0x14, 0x05, 0x00, 0x17, 0x17, 0xBE,
+		0x54, 0x2D, 0x00, 0x00, 0xC0, 0xB2, 0x00, 0x00, 0x87,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0xA9, 0x5C, 0x1E, 0x28, 0x00, 0x00,
+		0x05, 0x00, 0x14, 0x00, 0x0A, 0x00, 0x09, 0x00, 0x0F, 0x00, 0x0B,
+	})
+	copy(party[8+6*44:], []byte{0x91, 0x84, 0x83, 0x50})
+	copy(party[8+6*44+6*11:], []byte{0x92, 0x8F, 0x80, 0x91, 0x8A, 0x98, 0x50})
+
+	f, err := save.Load(data)
+	assert.NoError(t, err)
+
+	list, err := f.Party()
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+
+	p := list[0]
+	assert.Equal(t, byte(5), p.Level)
+	assert.Equal(t, uint16(20), p.HP)
+	assert.Equal(t, uint32(135), p.Exp)
+	assert.Equal(t, "RED", p.OTName)
+	assert.Equal(t, "SPARKY", p.Nickname)
+	assert.Equal(t, save.Stats{HP: 20, Attack: 10, Defense: 9, Speed: 15, Special: 11}, p.Stats)
+	assert.Equal(t, save.Stats{HP: 6, Attack: 10, Defense: 9, Speed: 5, Special: 12}, p.DV())
+}
+
+func TestSetParty(t *testing.T) {
+	f, err := save.Load(generate(t))
+	assert.NoError(t, err)
+
+	tauros := save.Pokemon{
+		Species:  0x3C,
+		Level:    100,
+		DVs:      save.PackDVs(save.Stats{Attack: 15, Defense: 15, Speed: 15, Special: 15}),
+		StatExp:  save.Stats{HP: 65535, Attack: 65535, Defense: 65535, Speed: 65535, Special: 65535},
+		Moves:    [4]byte{0x22},
+		PP:       [4]byte{15},
+		OTName:   "RED",
+		Nickname: "TAUROS",
+	}
+	assert.NoError(t, tauros.UpdateStats())
+	assert.Equal(t, save.Stats{HP: 353, Attack: 298, Defense: 288, Speed: 318, Special: 238}, tauros.Stats)
+
+	assert.NoError(t, f.SetParty([]save.Pokemon{tauros}))
+	f.RepairChecksums()
+
+	party, err := f.Party()
+	assert.NoError(t, err)
+	assert.Equal(t, []save.Pokemon{tauros}, party)
+	assert.Empty(t, save.Diagnose(f))
+
+	assert.Error(t, f.SetParty(make([]save.Pokemon, 7)))
+}
+
+func TestPK1_RoundTripThroughBox(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := pokegen.Gen(buf, pokegen.Options{
+		Game:  pokegen.GameRed,
+		Money: 3000,
+		Party: []pokegen.Pokemon{{Species: 0x3C, Level: 50, Moves: []byte{0x22}}},
+	})
+	assert.NoError(t, err)
+
+	f, err := save.Load(buf.Bytes())
+	assert.NoError(t, err)
+
+	pk1, err := f.ExportPK1(save.Slot{Party: true})
+	assert.NoError(t, err)
+	assert.Len(t, pk1, 69)
+
+	box2 := save.Slot{Box: 1}
+	assert.False(t, f.BoxesInitialised())
+	assert.NoError(t, f.ImportPK1(box2, pk1))
+	assert.True(t, f.BoxesInitialised())
+	f.RepairChecksums()
+
+	box, err := f.Box(1)
+	assert.NoError(t, err)
+	assert.Len(t, box, 1)
+	assert.Equal(t, "TAUROS", box[0].Nickname)
+	assert.Empty(t, save.Diagnose(f))
+
+	exported, err := f.ExportPK1(box2)
+	assert.NoError(t, err)
+	assert.Equal(t, pk1, exported)
+
+	assert.Error(t, f.ImportPK1(save.Slot{Box: 1, Index: 5}, pk1), "slots after the first empty one cannot be filled")
+	_, err = save.DecodePK1(pk1[:60], save.International)
+	assert.ErrorIs(t, err, save.ErrInvalidPK1)
+}
+
+func TestParseSlot(t *testing.T) {
+	slot, err := save.ParseSlot("box:3:12")
+	assert.NoError(t, err)
+	assert.Equal(t, save.Slot{Box: 2, Index: 11}, slot)
+	assert.Equal(t, "box:3:12", slot.String())
+
+	for _, invalid := range []string{"party", "party:0", "box:1", "pc:1:1"} {
+		_, err := save.ParseSlot(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	before, err := save.Load(generate(t))
+	assert.NoError(t, err)
+
+	data := generate(t)
+	data[save.International.Money+1] = 0x40
+	data[save.International.EventFlags+0x77/8] |= 1 << (0x77 % 8)
+	data[0x2D00] = 0x05
+	after, err := save.Load(data)
+	assert.NoError(t, err)
+
+	differences, err := save.Diff(before, after)
+	assert.NoError(t, err)
+
+	var lines []string
+	for _, d := range differences {
+		lines = append(lines, d.String())
+	}
+	assert.Equal(t, []string{
+		"money 3000 → 4000",
+		"event flag BEAT_BROCK set",
+		"bytes 0x2D00-0x2D00 (main data) 00 → 05",
+	}, lines)
+}
+
+func TestRepairChecksums(t *testing.T) {
+	data := generate(t)
+	data[save.International.Money] = 0x99
+	data[save.International.CurrentBox] |= 0x80
+
+	f, err := save.Load(data)
+	assert.NoError(t, err)
+
+	results := f.RepairChecksums()
+	assert.Len(t, results, 15, "main, two banks and twelve boxes")
+	assert.False(t, results[0].Valid())
+
+	for _, r := range f.Checksums() {
+		assert.True(t, r.Valid(), r.Name)
+	}
+	assert.Equal(t, results[0].Expected, f.Bytes()[save.International.MainChecksum])
+}
+
+func TestBoxChecksums_Uninitialised(t *testing.T) {
+	f, err := save.Load(generate(t))
+	assert.NoError(t, err)
+	assert.False(t, f.BoxesInitialised())
+
+	results := f.BoxChecksums()
+	assert.Len(t, results, 14, "two banks and twelve boxes")
+	for _, r := range results {
+		assert.Equal(t, "boxes uninitialised, not checked", r.Skipped, r.Name)
+		assert.True(t, r.Valid(), r.Name)
+	}
+
+	before := append([]byte(nil), f.Bytes()...)
+	f.RepairChecksums()
+	assert.Equal(t, before[0x4000:], f.Bytes()[0x4000:], "uninitialised banks should be left as they are")
+}
+
+func TestDiagnose_GeneratedSave(t *testing.T) {
+	f, err := save.Load(generate(t))
+	assert.NoError(t, err)
+	assert.Empty(t, save.Diagnose(f))
+}
+
+func TestDiagnose_BrokenSave(t *testing.T) {
+	data := generate(t)
+	l := save.International
+	copy(data[l.PlayerName:], []byte{0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80})
+	data[l.Party] = 7
+	data[l.Bag], data[l.Bag+1], data[l.Bag+2], data[l.Bag+3] = 1, 0x14, 0x01, 0x00
+	data[l.BoxData], data[l.BoxData+1], data[l.BoxData+2] = 1, 0x1F, 0x00
+
+	f, err := save.Load(data)
+	assert.NoError(t, err)
+
+	var fields []string
+	for _, p := range save.Diagnose(f) {
+		if p.Severity == save.SeverityError {
+			fields = append(fields, p.Field)
+		}
+	}
+	assert.ElementsMatch(t, []string{
+		"main checksum",
+		"player name",
+		"bag",
+		"party",
+		"current box",
+		"current box[1]",
+		"current box[1]",
+		"current box[1] OT name",
+		"current box[1] nickname",
+	}, fields)
+}
+
+func TestReconcile(t *testing.T) {
+	data := generate(t)
+	l := save.International
+	// A TOWN MAP and a BICYCLE in the bag
+	copy(data[l.Bag:], []byte{2, 0x05, 0x01, 0x06, 0x01, 0xFF})
+
+	f, err := save.Load(data)
+	assert.NoError(t, err)
+
+	tauros := save.Pokemon{Species: 0x3C, Level: 50, OTName: "RED", Nickname: "TAUROS"}
+	pidgey := save.Pokemon{Species: 0x24, Level: 10, OTName: "RED", Nickname: "PIDGEY"}
+	assert.NoError(t, f.SetParty([]save.Pokemon{tauros}))
+	assert.NoError(t, f.SetBox(1, []save.Pokemon{pidgey}))
+	// Written after the banks are initialised, so only the working copy of box 1 holds it.
+	assert.NoError(t, f.SetBox(0, []save.Pokemon{tauros}))
+
+	b := f.Bytes()
+	b[l.Party+1] = 0x99
+
+	fixes, err := f.Reconcile()
+	assert.NoError(t, err)
+	assert.Equal(t, []save.Fix{
+		{Field: "party", Message: "species list entry 1 changed from 0x99 to 0x3C to match its pokémon"},
+		{Field: "box 1", Message: "copied from the current box"},
+		{Field: "pokedex", Message: "marked TAUROS as owned"},
+		{Field: "pokedex", Message: "marked TAUROS as seen"},
+		{Field: "pokedex", Message: "marked PIDGEY as owned"},
+		{Field: "pokedex", Message: "marked PIDGEY as seen"},
+		{Field: "event flags", Message: "set GOT_TOWN_MAP"},
+		{Field: "missable objects", Message: "hid the town map in the rival's house"},
+		{Field: "event flags", Message: "set GOT_BICYCLE"},
+	}, fixes)
+
+	fixes, err = f.Reconcile()
+	assert.NoError(t, err)
+	assert.Empty(t, fixes, "a reconciled save should need no further fixes")
+
+	f.RepairChecksums()
+	assert.Empty(t, save.Diagnose(f))
+}
+
+func TestReconcile_CountExceedsCapacity(t *testing.T) {
+	data := generate(t)
+	data[save.International.Party] = 7
+
+	f, err := save.Load(data)
+	assert.NoError(t, err)
+	_, err = f.Reconcile()
+	assert.Error(t, err)
+}
+
+func TestTrade(t *testing.T) {
+	kadabra, _ := gamedata.SpeciesByName("Kadabra")
+	graveler, _ := gamedata.SpeciesByName("Graveler")
+	id := uint16(54321)
+
+	buf := new(bytes.Buffer)
+	err := pokegen.Gen(buf, pokegen.Options{
+		Game:       pokegen.GameRed,
+		PlayerName: "RED",
+		Party:      []pokegen.Pokemon{{Species: kadabra.Index, Level: 30}},
+	})
+	assert.NoError(t, err)
+	a, err := save.Load(buf.Bytes())
+	assert.NoError(t, err)
+
+	buf.Reset()
+	err = pokegen.Gen(buf, pokegen.Options{
+		Game:       pokegen.GameBlue,
+		PlayerName: "GARY",
+		Party:      []pokegen.Pokemon{{Species: 0x24, Level: 5}},
+		Boxes:      map[int][]pokegen.Pokemon{0: {{Species: graveler.Index, Level: 28, Nickname: "ROCKY", OTID: &id}}},
+	})
+	assert.NoError(t, err)
+	b, err := save.Load(buf.Bytes())
+	assert.NoError(t, err)
+
+	toA, toB, err := save.Trade(a, save.Slot{Party: true}, b, save.Slot{Box: 0}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, save.Traded{Species: "GRAVELER", Evolved: "GOLEM"}, toA)
+	assert.Equal(t, save.Traded{Species: "KADABRA", Evolved: "ALAKAZAM"}, toB)
+
+	received, err := a.Pokemon(save.Slot{Party: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "ROCKY", received.Nickname, "nicknames should be kept")
+	assert.Equal(t, "GARY", received.OTName)
+	assert.Equal(t, id, received.OTID)
+	assert.NotZero(t, received.Stats.HP, "pokémon placed in the party should have stats")
+	assert.Equal(t, received.Stats.HP, received.HP)
+	assert.True(t, a.PokedexOwned(75))
+	assert.True(t, a.PokedexOwned(76))
+
+	alakazam, err := b.Pokemon(save.Slot{Box: 0})
+	assert.NoError(t, err)
+	assert.Equal(t, "ALAKAZAM", alakazam.Nickname, "species names should change with the species")
+	assert.Equal(t, "RED", alakazam.OTName)
+	assert.Equal(t, a.PlayerID(), alakazam.OTID)
+	assert.True(t, b.PokedexOwned(65))
+
+	a.RepairChecksums()
+	b.RepairChecksums()
+	assert.Empty(t, save.Diagnose(a))
+	assert.Empty(t, save.Diagnose(b))
+	for _, f := range []*save.File{a, b} {
+		findings, err := legality.CheckSave(f, gamedata.VersionRed)
+		assert.NoError(t, err)
+		assert.Empty(t, findings)
+	}
+
+	// Trading back without evolving returns the Pokémon as they are.
+	_, _, err = save.Trade(a, save.Slot{Party: true}, b, save.Slot{Box: 0}, false)
+	assert.NoError(t, err)
+	p, err := b.Pokemon(save.Slot{Box: 0})
+	assert.NoError(t, err)
+	golem, _ := gamedata.SpeciesByName("Golem")
+	assert.Equal(t, "ROCKY", p.Nickname)
+	assert.Equal(t, golem.Index, p.Species, "should stay evolved")
+}
+
+func TestTrade_Invalid(t *testing.T) {
+	a, err := save.Load(generate(t))
+	assert.NoError(t, err)
+	b, err := save.Load(generate(t))
+	assert.NoError(t, err)
+	before := append([]byte(nil), b.Bytes()...)
+
+	_, _, err = save.Trade(a, save.Slot{Party: true}, a, save.Slot{Box: 0}, false)
+	assert.ErrorIs(t, err, save.ErrSameSave)
+	_, _, err = save.Trade(a, save.Slot{Party: true}, b, save.Slot{Party: true}, false)
+	assert.EqualError(t, err, "save a: slot party:1 is empty")
+	assert.Equal(t, before, b.Bytes(), "a failed trade should not change either save")
+}
+
+func TestMerge(t *testing.T) {
+	beatBrock, _ := gamedata.EventFlagByName("BEAT_BROCK")
+	gen := func(opts pokegen.Options) *save.File {
+		opts.Game = pokegen.GameRed
+		buf := new(bytes.Buffer)
+		err := pokegen.Gen(buf, opts)
+		assert.NoError(t, err)
+		f, err := save.Load(buf.Bytes())
+		assert.NoError(t, err)
+		return f
+	}
+
+	base := gen(pokegen.Options{PlayerName: "RED", Party: []pokegen.Pokemon{{Species: 0x3C, Level: 50}}, Reconcile: true})
+	progress := gen(pokegen.Options{
+		PlayerName: "ASH",
+		Badges:     0x1F,
+		Events:     []int{beatBrock},
+		Location:   &save.Location{Map: 0x07, X: 19, Y: 28},
+		Bag:        []save.ItemStack{{Item: 0x13, Quantity: 10}},
+	})
+	boxes := gen(pokegen.Options{Boxes: map[int][]pokegen.Pokemon{2: {{Species: 0x24, Level: 10}}}})
+
+	fixes, err := base.Merge([]save.Take{
+		{Region: save.Region{Name: save.RegionProgress}, From: progress},
+		{Region: save.Region{Name: save.RegionBag}, From: progress},
+		{Region: save.Region{Name: save.RegionBox, Box: 2}, From: boxes},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, fixes, save.Fix{Field: "pokedex", Message: "marked PIDGEY as owned"})
+
+	name, err := base.PlayerName()
+	assert.NoError(t, err)
+	assert.Equal(t, "RED", name, "regions not taken should be kept")
+	party, err := base.Party()
+	assert.NoError(t, err)
+	assert.Len(t, party, 1)
+
+	assert.Equal(t, byte(0x1F), base.Badges())
+	assert.True(t, base.EventFlag(beatBrock))
+	assert.Equal(t, save.Location{Map: 0x07, X: 19, Y: 28}, base.Location())
+	bag, err := base.Bag()
+	assert.NoError(t, err)
+	assert.Equal(t, []save.ItemStack{{Item: 0x13, Quantity: 10}}, bag)
+	box, err := base.Box(2)
+	assert.NoError(t, err)
+	assert.Len(t, box, 1)
+	assert.Equal(t, "PIDGEY", box[0].Nickname)
+
+	base.RepairChecksums()
+	assert.Empty(t, save.Diagnose(base))
+}
+
+func TestMerge_Invalid(t *testing.T) {
+	f, err := save.Load(generate(t))
+	assert.NoError(t, err)
+	japanese, err := save.LoadLayout(generate(t), save.Japanese)
+	assert.NoError(t, err)
+
+	_, err = f.Merge([]save.Take{{Region: save.Region{Name: save.RegionProgress}, From: japanese}})
+	assert.EqualError(t, err, "progress: cannot be taken from a save with 6 character names into one with 11")
+	_, err = f.Merge([]save.Take{{Region: save.Region{Name: save.RegionBoxes}, From: japanese}})
+	assert.EqualError(t, err, "boxes: cannot take 8 boxes into a save with 12")
+}
+
+func TestParseRegion(t *testing.T) {
+	r, err := save.ParseRegion("box:3")
+	assert.NoError(t, err)
+	assert.Equal(t, save.Region{Name: save.RegionBox, Box: 2}, r)
+	assert.Equal(t, "box:3", r.String())
+
+	r, err = save.ParseRegion("progress")
+	assert.NoError(t, err)
+	assert.Equal(t, save.Region{Name: save.RegionProgress}, r)
+
+	for _, s := range []string{"box:0", "box:x", "box", "badges"} {
+		_, err := save.ParseRegion(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestEdit(t *testing.T) {
+	pikachu, _ := gamedata.SpeciesByName("Pikachu")
+	eevee, _ := gamedata.SpeciesByName("Eevee")
+	thundershock, _ := gamedata.MoveByName("Thundershock")
+
+	buf := new(bytes.Buffer)
+	err := pokegen.Gen(buf, pokegen.Options{
+		Game:  pokegen.GameRed,
+		Party: []pokegen.Pokemon{{Species: pikachu.Index, Level: 25, Moves: []byte{thundershock.ID}, Nickname: "SPARKY"}},
+		Boxes: map[int][]pokegen.Pokemon{1: {{Species: eevee.Index, Level: 20}}},
+	})
+	assert.NoError(t, err)
+	f, err := save.Load(buf.Bytes())
+	assert.NoError(t, err)
+
+	party, err := f.Party()
+	assert.NoError(t, err)
+	party[0].HP, party[0].Status, party[0].PP[0] = 1, 0x08, 0
+	assert.NoError(t, f.SetParty(party))
+
+	assert.NoError(t, f.Edit([]save.Operation{
+		{Op: save.OpHeal},
+		{Op: save.OpMaxPP, Slot: "party:1"},
+		{Op: save.OpSetLevel, Level: 30},
+		{Op: save.OpEvolve, Slot: "party:1"},
+		{Op: save.OpEvolve, Slot: "box:2:1", Into: "Vaporeon"},
+	}))
+
+	raichu, err := f.Pokemon(save.Slot{Party: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "SPARKY", raichu.Nickname, "nicknames should be kept")
+	assert.Equal(t, byte(30), raichu.Level)
+	assert.Equal(t, byte(0), raichu.Status)
+	assert.Equal(t, raichu.Stats.HP, raichu.HP)
+	assert.Equal(t, byte(3<<6|48), raichu.PP[0], "thundershock should have 3 PP Ups and 48 PP")
+	assert.True(t, f.PokedexOwned(26))
+
+	vaporeon, err := f.Pokemon(save.Slot{Box: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, "VAPOREON", vaporeon.Nickname)
+	assert.Equal(t, byte(30), vaporeon.Level, "boxed pokémon should be edited too")
+	assert.Equal(t, save.Stats{}, vaporeon.Stats, "boxed pokémon should not store stats")
+
+	f.RepairChecksums()
+	assert.Empty(t, save.Diagnose(f))
+	findings, err := legality.CheckSave(f, gamedata.VersionRed)
+	assert.NoError(t, err)
+	assert.Empty(t, findings)
+}
+
+func TestEdit_HealStoredMaxHP(t *testing.T) {
+	pikachu, _ := gamedata.SpeciesByName("Pikachu")
+	p := save.Pokemon{Species: pikachu.Index, Level: 25, Exp: 15625}
+	assert.NoError(t, p.UpdateStats())
+
+	// The game heals party Pokémon to the maximum HP they store, even when it differs from the one calculated.
+	p.Stats.HP += 5
+	p.HP = 1
+	assert.NoError(t, p.Heal())
+	assert.Equal(t, p.Stats.HP, p.HP)
+
+	boxed := save.Pokemon{Species: pikachu.Index, Level: 25, Exp: 15625, HP: 1}
+	assert.NoError(t, boxed.Heal())
+	assert.Equal(t, p.Stats.HP-5, boxed.HP, "boxed pokémon should be healed to the calculated maximum")
+}
+
+func TestEdit_Invalid(t *testing.T) {
+	f, err := save.Load(generate(t))
+	assert.NoError(t, err)
+	before := append([]byte(nil), f.Bytes()...)
+
+	for _, test := range []struct {
+		ops []save.Operation
+		err string
+	}{
+		{[]save.Operation{{Op: "revive"}}, `operation 1 (revive): unknown operation "revive", want heal, max_pp, set_level, evolve, sort, move or release`},
+		{[]save.Operation{{Op: save.OpHeal}, {Op: save.OpEvolve}}, "operation 2 (evolve): evolve needs the slot of the pokémon to evolve"},
+		{[]save.Operation{{Op: save.OpSetLevel, Slot: "party:1", Level: 50}}, "operation 1 (set_level party:1): slot party:1 is empty"},
+		{[]save.Operation{{Op: save.OpSort, By: "weight"}}, `operation 1 (sort): unknown order "weight", want dex, level or name`},
+		{[]save.Operation{{Op: save.OpSort, By: save.SortDex, Box: 13}}, "operation 1 (sort): box 13 does not exist"},
+		{[]save.Operation{{Op: save.OpMove, Slot: "party:1"}}, "operation 1 (move party:1): move needs the slot of the pokémon to move and the slot to move it to"},
+		{[]save.Operation{{Op: save.OpRelease}}, "operation 1 (release): release needs the slot of the pokémon to release"},
+		{[]save.Operation{{Op: save.OpRelease, Slot: "box:1:1"}}, "operation 1 (release box:1:1): slot box:1:1 is empty"},
+	} {
+		assert.EqualError(t, f.Edit(test.ops), test.err)
+	}
+	assert.Equal(t, before, f.Bytes(), "a failed edit should not change the save")
+}
+
+func TestEdit_Organise(t *testing.T) {
+	species := func(name string) byte {
+		s, _ := gamedata.SpeciesByName(name)
+		return s.Index
+	}
+
+	buf := new(bytes.Buffer)
+	err := pokegen.Gen(buf, pokegen.Options{
+		Game: pokegen.GameRed,
+		Party: []pokegen.Pokemon{
+			{Species: species("Pikachu"), Level: 25},
+			{Species: species("Pidgey"), Level: 10},
+		},
+		Boxes: map[int][]pokegen.Pokemon{
+			0: {{Species: species("Tauros"), Level: 30}, {Species: species("Abra"), Level: 12}},
+			2: {{Species: species("Bulbasaur"), Level: 5, Nickname: "ZED"}, {Species: species("Snorlax"), Level: 30}},
+		},
+	})
+	assert.NoError(t, err)
+	f, err := save.Load(buf.Bytes())
+	assert.NoError(t, err)
+
+	names := func(list []save.Pokemon) []string {
+		var names []string
+		for _, p := range list {
+			names = append(names, p.Nickname)
+		}
+		return names
+	}
+
+	assert.NoError(t, f.Edit([]save.Operation{{Op: save.OpSort, By: save.SortName, Box: 3}}))
+	box, err := f.Box(2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"SNORLAX", "ZED"}, names(box))
+
+	assert.NoError(t, f.Edit([]save.Operation{{Op: save.OpSort, By: save.SortDex}}))
+	box, err = f.Box(0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ZED", "ABRA", "TAUROS", "SNORLAX"}, names(box), "sorting every box should pack them into the first")
+	box, err = f.Box(2)
+	assert.NoError(t, err)
+	assert.Empty(t, box)
+
+	assert.NoError(t, f.Edit([]save.Operation{
+		{Op: save.OpMove, Slot: "box:1:4", To: "party:1"},
+		{Op: save.OpMove, Slot: "party:3", To: "box:2:1"},
+		{Op: save.OpMove, Slot: "box:1:1", To: "box:1:3"},
+		{Op: save.OpRelease, Slot: "party:2"},
+	}))
+
+	party, err := f.Party()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"SNORLAX"}, names(party))
+	assert.NotZero(t, party[0].Stats.HP, "withdrawn pokémon should have their stats calculated")
+	box, err = f.Box(0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ABRA", "TAUROS", "ZED"}, names(box))
+	box, err = f.Box(1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"PIDGEY"}, names(box))
+	assert.Equal(t, save.Stats{}, box[0].Stats, "deposited pokémon should not store stats")
+
+	assert.EqualError(t, f.Edit([]save.Operation{{Op: save.OpRelease, Slot: "party:1"}}), "operation 1 (release party:1): the party cannot be left empty")
+	assert.EqualError(t, f.Edit([]save.Operation{{Op: save.OpMove, Slot: "box:1:1", To: "box:2:3"}}), "operation 1 (move box:1:1): slot box:2:3 is after the first empty slot 2")
+
+	f.RepairChecksums()
+	assert.Empty(t, save.Diagnose(f))
+}
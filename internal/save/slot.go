@@ -0,0 +1,89 @@
+package save
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Slot is a place a Pokémon is stored: a position in the party or in a PC box.
+// Box and Index are zero based; Box is ignored for party slots.
+type Slot struct {
+	Party bool
+	Box   int
+	Index int
+}
+
+// ParseSlot parses a one based slot such as "party:1" or "box:3:12".
+func ParseSlot(s string) (Slot, error) {
+	parts := strings.Split(s, ":")
+	numbers := make([]int, len(parts)-1)
+	for i, part := range parts[1:] {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 1 {
+			return Slot{}, fmt.Errorf("slot %q: %q is not a positive number", s, part)
+		}
+		numbers[i] = n - 1
+	}
+
+	switch {
+	case parts[0] == "party" && len(numbers) == 1:
+		return Slot{Party: true, Index: numbers[0]}, nil
+	case parts[0] == "box" && len(numbers) == 2:
+		return Slot{Box: numbers[0], Index: numbers[1]}, nil
+	}
+	return Slot{}, fmt.Errorf("slot %q must be party:<position> or box:<box>:<position>", s)
+}
+
+func (s Slot) String() string {
+	if s.Party {
+		return fmt.Sprintf("party:%d", s.Index+1)
+	}
+	return fmt.Sprintf("box:%d:%d", s.Box+1, s.Index+1)
+}
+
+// list returns the Pokémon in the party or box holding the slot.
+func (f *File) list(s Slot) ([]Pokemon, error) {
+	if s.Party {
+		return f.Party()
+	}
+	return f.Box(s.Box)
+}
+
+func (f *File) setList(s Slot, list []Pokemon) error {
+	if s.Party {
+		return f.SetParty(list)
+	}
+	return f.SetBox(s.Box, list)
+}
+
+// Pokemon returns the Pokémon in slot.
+func (f *File) Pokemon(s Slot) (Pokemon, error) {
+	list, err := f.list(s)
+	if err != nil {
+		return Pokemon{}, err
+	}
+	if s.Index < 0 || s.Index >= len(list) {
+		return Pokemon{}, fmt.Errorf("slot %s is empty", s)
+	}
+	return list[s.Index], nil
+}
+
+// SetPokemon stores p in slot, replacing the Pokémon there or, if the slot is the first empty one, adding it.
+func (f *File) SetPokemon(s Slot, p Pokemon) error {
+	list, err := f.list(s)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case s.Index >= 0 && s.Index < len(list):
+		list[s.Index] = p
+	case s.Index == len(list):
+		list = append(list, p)
+	default:
+		return fmt.Errorf("slot %s is after the first empty slot %d", s, len(list)+1)
+	}
+
+	return f.setList(s, list)
+}
@@ -0,0 +1,249 @@
+package save
+
+import (
+	"fmt"
+	"pokegen/internal/gamedata"
+	"pokegen/internal/util"
+	"strings"
+)
+
+var badgeNames = [8]string{"BOULDER", "CASCADE", "THUNDER", "RAINBOW", "SOUL", "MARSH", "VOLCANO", "EARTH"}
+
+// BadgeByName returns the bit of the badge with the given name in the badges bit field.
+// The name may be written with or without "badge", ignoring case, such as "Boulder" or "BOULDERBADGE".
+func BadgeByName(name string) (int, bool) {
+	name = strings.TrimSuffix(gamedata.NormaliseName(name), "badge")
+	for i, badge := range badgeNames {
+		if gamedata.NormaliseName(badge) == name {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// Summary is a human-readable overview of a save.
+type Summary struct {
+	PlayerName string           `json:"player_name"`
+	RivalName  string           `json:"rival_name"`
+	PlayerID   uint16           `json:"player_id"`
+	Money      uint64           `json:"money"`
+	Coins      uint64           `json:"coins"`
+	Badges     []string         `json:"badges"`
+	PlayTime   PlayTime         `json:"play_time"`
+	Location   LocationSummary  `json:"location"`
+	Party      []PokemonSummary `json:"party"`
+	Boxes      []BoxSummary     `json:"boxes"`
+	Bag        []ItemSummary    `json:"bag"`
+	PCItems    []ItemSummary    `json:"pc_items"`
+	Pokedex    PokedexSummary   `json:"pokedex"`
+
+	PikachuFriendship byte `json:"pikachu_friendship"`
+
+	Checksums []ChecksumResult `json:"checksums"`
+}
+
+type LocationSummary struct {
+	Location
+	Name string `json:"name"`
+}
+
+type PokemonSummary struct {
+	Species  string        `json:"species"`
+	Dex      int           `json:"dex"`
+	Nickname string        `json:"nickname"`
+	OTName   string        `json:"ot_name"`
+	OTID     uint16        `json:"ot_id"`
+	Level    byte          `json:"level"`
+	HP       uint16        `json:"hp"`
+	Status   string        `json:"status,omitempty"`
+	Exp      uint32        `json:"exp"`
+	Stats    *Stats        `json:"stats,omitempty"`
+	StatExp  Stats         `json:"stat_exp"`
+	DVs      Stats         `json:"dvs"`
+	Moves    []MoveSummary `json:"moves"`
+}
+
+type MoveSummary struct {
+	Name  string `json:"name"`
+	PP    byte   `json:"pp"`
+	PPUps byte   `json:"pp_ups"`
+}
+
+type BoxSummary struct {
+	Number  int              `json:"number"`
+	Current bool             `json:"current"`
+	Pokemon []PokemonSummary `json:"pokemon"`
+}
+
+type ItemSummary struct {
+	Name     string `json:"name"`
+	Quantity byte   `json:"quantity"`
+}
+
+type PokedexSummary struct {
+	Owned int `json:"owned"`
+	Seen  int `json:"seen"`
+}
+
+// Summary reads the save into a Summary.
+func (f *File) Summary() (Summary, error) {
+	var s Summary
+	var err error
+
+	s.PlayerName, err = f.PlayerName()
+	if err != nil {
+		return Summary{}, fmt.Errorf("player name: %w", err)
+	}
+	s.PlayerName = util.DisplayText(s.PlayerName)
+
+	s.RivalName, err = f.RivalName()
+	if err != nil {
+		return Summary{}, fmt.Errorf("rival name: %w", err)
+	}
+	s.RivalName = util.DisplayText(s.RivalName)
+
+	s.PlayerID = f.PlayerID()
+
+	s.Money, err = f.Money()
+	if err != nil {
+		return Summary{}, fmt.Errorf("money: %w", err)
+	}
+
+	s.Coins, err = f.Coins()
+	if err != nil {
+		return Summary{}, fmt.Errorf("coins: %w", err)
+	}
+
+	s.Badges = []string{}
+	for i, name := range badgeNames {
+		if f.Badges()&(1<<i) != 0 {
+			s.Badges = append(s.Badges, name)
+		}
+	}
+
+	s.PlayTime = f.PlayTime()
+
+	s.Location.Location = f.Location()
+	s.Location.Name = mapName(s.Location.Map)
+
+	party, err := f.Party()
+	if err != nil {
+		return Summary{}, fmt.Errorf("party: %w", err)
+	}
+	s.Party = summarisePokemon(party)
+
+	for n := 0; n < f.layout.Boxes; n++ {
+		box, err := f.Box(n)
+		if err != nil {
+			return Summary{}, fmt.Errorf("box %d: %w", n+1, err)
+		}
+		s.Boxes = append(s.Boxes, BoxSummary{
+			Number:  n + 1,
+			Current: n == f.CurrentBox(),
+			Pokemon: summarisePokemon(box),
+		})
+	}
+
+	bag, err := f.Bag()
+	if err != nil {
+		return Summary{}, fmt.Errorf("bag: %w", err)
+	}
+	s.Bag = summariseItems(bag)
+
+	pcItems, err := f.PCItems()
+	if err != nil {
+		return Summary{}, fmt.Errorf("pc items: %w", err)
+	}
+	s.PCItems = summariseItems(pcItems)
+
+	s.Pokedex.Owned, s.Pokedex.Seen = f.PokedexCounts()
+
+	s.PikachuFriendship = f.PikachuFriendship()
+
+	s.Checksums = f.Checksums()
+
+	return s, nil
+}
+
+func summarisePokemon(list []Pokemon) []PokemonSummary {
+	summaries := make([]PokemonSummary, 0, len(list))
+	for _, p := range list {
+		ps := PokemonSummary{
+			Species:  fmt.Sprintf("UNKNOWN (0x%02X)", p.Species),
+			Nickname: util.DisplayText(p.Nickname),
+			OTName:   util.DisplayText(p.OTName),
+			OTID:     p.OTID,
+			Level:    p.Level,
+			HP:       p.HP,
+			Status:   statusName(p.Status),
+			Exp:      p.Exp,
+			StatExp:  p.StatExp,
+			DVs:      p.DV(),
+			Moves:    []MoveSummary{},
+		}
+
+		if species, ok := gamedata.SpeciesByIndex(p.Species); ok {
+			ps.Species = species.Name
+			ps.Dex = species.Dex
+		}
+
+		if p.Stats != (Stats{}) {
+			stats := p.Stats
+			ps.Stats = &stats
+		}
+
+		for i, id := range p.Moves {
+			if id == 0 {
+				continue
+			}
+			name := fmt.Sprintf("UNKNOWN (0x%02X)", id)
+			if move, ok := gamedata.MoveByID(id); ok {
+				name = move.Name
+			}
+			ps.Moves = append(ps.Moves, MoveSummary{
+				Name:  name,
+				PP:    p.PP[i] & 0x3F,
+				PPUps: p.PP[i] >> 6,
+			})
+		}
+
+		summaries = append(summaries, ps)
+	}
+	return summaries
+}
+
+func summariseItems(stacks []ItemStack) []ItemSummary {
+	summaries := make([]ItemSummary, 0, len(stacks))
+	for _, stack := range stacks {
+		name := fmt.Sprintf("UNKNOWN (0x%02X)", stack.Item)
+		if item, ok := gamedata.ItemByID(stack.Item); ok {
+			name = item.Name
+		}
+		summaries = append(summaries, ItemSummary{Name: name, Quantity: stack.Quantity})
+	}
+	return summaries
+}
+
+func mapName(id byte) string {
+	if name, ok := gamedata.MapName(id); ok {
+		return name
+	}
+	return fmt.Sprintf("UNKNOWN (0x%02X)", id)
+}
+
+// statusName describes a status condition byte: bits 0-2 are the sleep counter, followed by poison, burn, freeze and paralysis.
+func statusName(status byte) string {
+	switch {
+	case status&0x07 != 0:
+		return "SLP"
+	case status&0x08 != 0:
+		return "PSN"
+	case status&0x10 != 0:
+		return "BRN"
+	case status&0x20 != 0:
+		return "FRZ"
+	case status&0x40 != 0:
+		return "PAR"
+	}
+	return ""
+}
@@ -0,0 +1,101 @@
+package save
+
+import (
+	"errors"
+	"fmt"
+	"pokegen/internal/gamedata"
+)
+
+// ErrSameSave is returned when a save is asked to trade with itself.
+var ErrSameSave = errors.New("cannot trade a save with itself")
+
+// Traded describes a Pokémon received in a trade: the species sent and, if it evolved on arrival, the species it became.
+type Traded struct {
+	Species string `json:"species"`
+	Evolved string `json:"evolved,omitempty"`
+}
+
+// Trade swaps the Pokémon in slot sa of a with the one in slot sb of b as a link trade would,
+// returning what each save received.
+// Each Pokémon keeps its OT name and ID and takes the place of the one sent for it, gaining or losing its stored stats
+// when moving between the party and a box, and is marked as seen and owned in its new save's Pokédex.
+// With evolve set, Pokémon that evolve by trading, such as Kadabra, evolve on arrival.
+// Neither save is changed if the trade fails.
+func Trade(a *File, sa Slot, b *File, sb Slot, evolve bool) (toA, toB Traded, err error) {
+	if a == b {
+		return Traded{}, Traded{}, ErrSameSave
+	}
+
+	pa, err := a.Pokemon(sa)
+	if err != nil {
+		return Traded{}, Traded{}, fmt.Errorf("save a: %w", err)
+	}
+	pb, err := b.Pokemon(sb)
+	if err != nil {
+		return Traded{}, Traded{}, fmt.Errorf("save b: %w", err)
+	}
+
+	// Trade between copies so a failure part way through leaves both saves as they were.
+	ca, _ := LoadLayout(a.data, a.layout)
+	cb, _ := LoadLayout(b.data, b.layout)
+
+	toA, err = ca.receive(sa, pb, evolve)
+	if err != nil {
+		return Traded{}, Traded{}, fmt.Errorf("save a: %w", err)
+	}
+	toB, err = cb.receive(sb, pa, evolve)
+	if err != nil {
+		return Traded{}, Traded{}, fmt.Errorf("save b: %w", err)
+	}
+
+	copy(a.data, ca.data)
+	copy(b.data, cb.data)
+	return toA, toB, nil
+}
+
+// receive places p, traded from another save, in slot s.
+func (f *File) receive(s Slot, p Pokemon, evolve bool) (Traded, error) {
+	species, ok := gamedata.SpeciesByIndex(p.Species)
+	if !ok {
+		return Traded{}, fmt.Errorf("unknown species 0x%02X", p.Species)
+	}
+	traded := Traded{Species: species.Name}
+	f.SetPokedexSeen(species.Dex, true)
+	f.SetPokedexOwned(species.Dex, true)
+
+	if evolve {
+		if into, ok := tradeEvolution(species.Dex); ok {
+			if err := p.Evolve(into); err != nil {
+				return Traded{}, err
+			}
+			traded.Evolved = into.Name
+			f.SetPokedexSeen(into.Dex, true)
+			f.SetPokedexOwned(into.Dex, true)
+		}
+	}
+
+	switch {
+	case !s.Party:
+		p.Stats = Stats{}
+	case p.Stats == Stats{}:
+		// Pokémon sent from a box have no stats stored, so they are calculated as the game does when withdrawing them.
+		if err := p.UpdateStats(); err != nil {
+			return Traded{}, err
+		}
+	}
+
+	if err := f.SetPokemon(s, p); err != nil {
+		return Traded{}, err
+	}
+	return traded, nil
+}
+
+// tradeEvolution returns the species the species with the given Pokédex number evolves into when traded.
+func tradeEvolution(dex int) (gamedata.Species, bool) {
+	for _, e := range gamedata.EvolvesInto(dex) {
+		if e.Method == gamedata.EvolveTrade {
+			return gamedata.SpeciesByDex(e.Into)
+		}
+	}
+	return gamedata.Species{}, false
+}
@@ -0,0 +1,492 @@
+package scenario
+
+import (
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"pokegen/internal/gamedata"
+	"pokegen/internal/pokegen"
+	"pokegen/internal/save"
+	"strings"
+)
+
+// decoder applies the fields of a scenario's document to options, collecting an Error for each problem it finds.
+type decoder struct {
+	file string
+	errs Errors
+}
+
+func (d *decoder) errorf(n *yaml.Node, format string, args ...any) {
+	d.errs = append(d.errs, Error{File: d.file, Line: n.Line, Column: n.Column, Message: fmt.Sprintf(format, args...)})
+}
+
+// resolve follows an alias to the node it refers to.
+func resolve(n *yaml.Node) *yaml.Node {
+	for n.Kind == yaml.AliasNode {
+		n = n.Alias
+	}
+	return n
+}
+
+// lookup returns the value of the field with the given key, or nil if n is not a mapping or has no such field.
+func (d *decoder) lookup(n *yaml.Node, key string) *yaml.Node {
+	n = resolve(n)
+	if n.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// fields calls the function for each field of the mapping n, reporting fields it has no function for or that are set twice.
+func (d *decoder) fields(n *yaml.Node, what string, fields map[string]func(*yaml.Node)) {
+	n = resolve(n)
+	if n.Kind != yaml.MappingNode {
+		d.errorf(n, "%s must be a mapping", what)
+		return
+	}
+
+	seen := map[string]bool{}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		key, value := n.Content[i], n.Content[i+1]
+		field, ok := fields[key.Value]
+		switch {
+		case !ok:
+			d.errorf(key, "unknown field %q in %s", key.Value, what)
+		case seen[key.Value]:
+			d.errorf(key, "field %q is set twice in %s", key.Value, what)
+		default:
+			seen[key.Value] = true
+			field(value)
+		}
+	}
+}
+
+// sequence calls each for every item of the sequence n.
+func (d *decoder) sequence(n *yaml.Node, what string, each func(*yaml.Node)) {
+	n = resolve(n)
+	if n.Kind != yaml.SequenceNode {
+		d.errorf(n, "%s must be a list", what)
+		return
+	}
+	for _, item := range n.Content {
+		each(item)
+	}
+}
+
+func (d *decoder) str(n *yaml.Node, what string) (string, bool) {
+	n = resolve(n)
+	if n.Kind != yaml.ScalarNode || n.Tag == "!!null" {
+		d.errorf(n, "%s must be a string", what)
+		return "", false
+	}
+	return n.Value, true
+}
+
+// integer decodes an integer, written in decimal or with a 0x prefix in hexadecimal, between lowest and highest.
+func (d *decoder) integer(n *yaml.Node, what string, lowest, highest int) (int, bool) {
+	n = resolve(n)
+	var v int
+	if n.Kind != yaml.ScalarNode || n.Tag != "!!int" || n.Decode(&v) != nil {
+		d.errorf(n, "%s must be a whole number", what)
+		return 0, false
+	}
+	if v < lowest || v > highest {
+		d.errorf(n, "%s %d is not between %d and %d", what, v, lowest, highest)
+		return 0, false
+	}
+	return v, true
+}
+
+func (d *decoder) boolean(n *yaml.Node, what string) (bool, bool) {
+	n = resolve(n)
+	var v bool
+	if n.Kind != yaml.ScalarNode || n.Tag != "!!bool" || n.Decode(&v) != nil {
+		d.errorf(n, "%s must be true or false", what)
+		return false, false
+	}
+	return v, true
+}
+
+// isInt reports whether n is an integer, for fields that take either a name or a number.
+func isInt(n *yaml.Node) bool {
+	n = resolve(n)
+	return n.Kind == yaml.ScalarNode && n.Tag == "!!int"
+}
+
+func (d *decoder) scenario(n *yaml.Node, opts *pokegen.Options) {
+	// Missable objects are decoded once the game is known, as Yellow numbers some of them differently.
+	var missables *yaml.Node
+	d.fields(n, "scenario", map[string]func(*yaml.Node){
+		// extends is applied by the loader before the rest of the scenario.
+		"extends": func(*yaml.Node) {},
+		// description and tags describe the scenario to people choosing one, rather than the save.
+		"description": func(n *yaml.Node) {
+			d.str(n, "description")
+		},
+		"tags": func(n *yaml.Node) {
+			d.sequence(n, "tags", func(n *yaml.Node) { d.str(n, "tag") })
+		},
+		"game": func(n *yaml.Node) {
+			if game, ok := d.str(n, "game"); ok {
+				opts.Game = pokegen.Game(strings.ToLower(game))
+				switch opts.Game {
+				case pokegen.GameRed, pokegen.GameBlue, pokegen.GameYellow:
+				default:
+					d.errorf(n, "unknown game %q, want %s, %s or %s", game, pokegen.GameRed, pokegen.GameBlue, pokegen.GameYellow)
+				}
+			}
+		},
+		"language": func(n *yaml.Node) {
+			if language, ok := d.str(n, "language"); ok {
+				opts.Language = pokegen.Language(strings.ToLower(language))
+				if _, ok := save.Layouts[string(opts.Language)]; !ok {
+					d.errorf(n, "unknown language %q", language)
+				}
+			}
+		},
+		"trainer": func(n *yaml.Node) { d.trainer(n, opts) },
+		"starter": func(n *yaml.Node) {
+			if starter, ok := d.str(n, "starter"); ok {
+				opts.Starter = pokegen.Starter(strings.ToUpper(starter))
+				switch opts.Starter {
+				case pokegen.StarterBulbasaur, pokegen.StarterCharmander, pokegen.StarterSquirtle, pokegen.StarterPikachu:
+				default:
+					d.errorf(n, "unknown starter %q, want %s, %s, %s or %s", starter, pokegen.StarterBulbasaur, pokegen.StarterCharmander, pokegen.StarterSquirtle, pokegen.StarterPikachu)
+				}
+			}
+		},
+		"pikachu_friendship": func(n *yaml.Node) {
+			if friendship, ok := d.integer(n, "pikachu friendship", 0, 255); ok {
+				v := uint8(friendship)
+				opts.PikachuFriendship = &v
+			}
+		},
+		"party": func(n *yaml.Node) {
+			opts.Party = d.pokemonList(n, "party", 6)
+		},
+		"boxes": func(n *yaml.Node) { d.boxes(n, opts) },
+		"living_dex": func(n *yaml.Node) {
+			if livingDex, ok := d.boolean(n, "living dex"); ok {
+				opts.LivingDex = livingDex
+			}
+		},
+		"items": func(n *yaml.Node) {
+			d.fields(n, "items", map[string]func(*yaml.Node){
+				"bag": func(n *yaml.Node) { opts.Bag = d.items(n, "bag", save.BagCapacity) },
+				"pc":  func(n *yaml.Node) { opts.PCItems = d.items(n, "pc items", save.PCItemsCapacity) },
+			})
+		},
+		"flags": func(n *yaml.Node) {
+			d.sequence(n, "flags", func(n *yaml.Node) {
+				if flag, ok := d.eventFlag(n); ok {
+					opts.Events = append(opts.Events, flag)
+				}
+			})
+		},
+		"missables": func(n *yaml.Node) { missables = n },
+		"location":  func(n *yaml.Node) { d.location(n, opts) },
+		"reconcile": func(n *yaml.Node) {
+			if reconcile, ok := d.boolean(n, "reconcile"); ok {
+				opts.Reconcile = reconcile
+			}
+		},
+	})
+
+	if missables != nil {
+		d.fields(missables, "missables", map[string]func(*yaml.Node){
+			"hide": func(n *yaml.Node) { d.missableObjects(n, "hide", true, opts) },
+			"show": func(n *yaml.Node) { d.missableObjects(n, "show", false, opts) },
+		})
+	}
+}
+
+func (d *decoder) trainer(n *yaml.Node, opts *pokegen.Options) {
+	d.fields(n, "trainer", map[string]func(*yaml.Node){
+		"name": func(n *yaml.Node) {
+			if name, ok := d.str(n, "name"); ok {
+				opts.PlayerName = name
+			}
+		},
+		"rival": func(n *yaml.Node) {
+			if rival, ok := d.str(n, "rival"); ok {
+				opts.RivalName = rival
+			}
+		},
+		"money": func(n *yaml.Node) {
+			if money, ok := d.integer(n, "money", 0, 999999); ok {
+				opts.Money = uint64(money)
+			}
+		},
+		"badges": func(n *yaml.Node) {
+			var badges byte
+			d.sequence(n, "badges", func(n *yaml.Node) {
+				name, ok := d.str(n, "badge")
+				if !ok {
+					return
+				}
+				bit, ok := save.BadgeByName(name)
+				if !ok {
+					d.errorf(n, "unknown badge %q", name)
+					return
+				}
+				badges |= 1 << bit
+			})
+			opts.Badges = badges
+		},
+	})
+}
+
+func (d *decoder) boxes(n *yaml.Node, opts *pokegen.Options) {
+	n = resolve(n)
+	if n.Kind != yaml.MappingNode {
+		d.errorf(n, "boxes must be a mapping of box numbers to lists of pokémon")
+		return
+	}
+	if opts.Boxes == nil {
+		opts.Boxes = map[int][]pokegen.Pokemon{}
+	}
+
+	// Japanese saves have fewer but larger boxes; the language decides which are checked when the save is generated.
+	const mostBoxes, largestBox = 12, 30
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		box, ok := d.integer(n.Content[i], "box", 1, mostBoxes)
+		if !ok {
+			continue
+		}
+		opts.Boxes[box-1] = d.pokemonList(n.Content[i+1], fmt.Sprintf("box %d", box), largestBox)
+	}
+}
+
+// pokemonList decodes a list of at most capacity Pokémon.
+func (d *decoder) pokemonList(n *yaml.Node, what string, capacity int) []pokegen.Pokemon {
+	list := []pokegen.Pokemon{}
+	d.sequence(n, what, func(n *yaml.Node) {
+		if p, ok := d.pokemon(n); ok {
+			list = append(list, p)
+		}
+	})
+	if len(list) > capacity {
+		d.errorf(n, "%s has %d pokémon, the most it can hold is %d", what, len(list), capacity)
+	}
+	return list
+}
+
+func (d *decoder) pokemon(n *yaml.Node) (pokegen.Pokemon, bool) {
+	var p pokegen.Pokemon
+	errs := len(d.errs)
+
+	d.fields(n, "pokémon", map[string]func(*yaml.Node){
+		"species": func(n *yaml.Node) {
+			name, ok := d.str(n, "species")
+			if !ok {
+				return
+			}
+			s, ok := gamedata.SpeciesByName(name)
+			if !ok {
+				d.errorf(n, "unknown species %q", name)
+				return
+			}
+			p.Species = s.Index
+		},
+		"level": func(n *yaml.Node) {
+			p.Level, _ = d.integer(n, "level", 1, 100)
+		},
+		"moves": func(n *yaml.Node) {
+			d.sequence(n, "moves", func(n *yaml.Node) {
+				name, ok := d.str(n, "move")
+				if !ok {
+					return
+				}
+				m, ok := gamedata.MoveByName(name)
+				if !ok {
+					d.errorf(n, "unknown move %q", name)
+					return
+				}
+				p.Moves = append(p.Moves, m.ID)
+			})
+			if len(p.Moves) > 4 {
+				d.errorf(n, "got %d moves, want at most 4", len(p.Moves))
+			}
+		},
+		"nickname": func(n *yaml.Node) {
+			p.Nickname, _ = d.str(n, "nickname")
+		},
+		"ot_name": func(n *yaml.Node) {
+			p.OTName, _ = d.str(n, "OT name")
+		},
+		"ot_id": func(n *yaml.Node) {
+			if id, ok := d.integer(n, "OT ID", 0, 0xFFFF); ok {
+				v := uint16(id)
+				p.OTID = &v
+			}
+		},
+		"dvs": func(n *yaml.Node) {
+			p.DVs = d.stats(n, "DVs", 15, false)
+		},
+		"stat_exp": func(n *yaml.Node) {
+			p.StatExp = d.stats(n, "stat experience", 0xFFFF, true)
+		},
+	})
+
+	if resolve(n).Kind == yaml.MappingNode {
+		if d.lookup(n, "species") == nil {
+			d.errorf(n, "pokémon is missing its species")
+		}
+		if d.lookup(n, "level") == nil {
+			d.errorf(n, "pokémon is missing its level")
+		}
+	}
+	return p, len(d.errs) == errs
+}
+
+// stats decodes stats between 0 and highest. HP is only accepted when withHP is set, as the HP DV is derived from the others.
+func (d *decoder) stats(n *yaml.Node, what string, highest int, withHP bool) save.Stats {
+	var stats save.Stats
+	stat := func(name string, v *uint16) func(*yaml.Node) {
+		return func(n *yaml.Node) {
+			if s, ok := d.integer(n, what+" "+name, 0, highest); ok {
+				*v = uint16(s)
+			}
+		}
+	}
+
+	fields := map[string]func(*yaml.Node){
+		"attack":  stat("attack", &stats.Attack),
+		"defense": stat("defense", &stats.Defense),
+		"speed":   stat("speed", &stats.Speed),
+		"special": stat("special", &stats.Special),
+	}
+	if withHP {
+		fields["hp"] = stat("HP", &stats.HP)
+	}
+	d.fields(n, what, fields)
+	return stats
+}
+
+// items decodes a list of at most capacity item stacks. Quantities default to 1.
+func (d *decoder) items(n *yaml.Node, what string, capacity int) []save.ItemStack {
+	items := []save.ItemStack{}
+	d.sequence(n, what, func(n *yaml.Node) {
+		stack := save.ItemStack{Quantity: 1}
+		d.fields(n, "item", map[string]func(*yaml.Node){
+			"item": func(n *yaml.Node) {
+				name, ok := d.str(n, "item")
+				if !ok {
+					return
+				}
+				item, ok := gamedata.ItemByName(name)
+				if !ok {
+					d.errorf(n, "unknown item %q", name)
+					return
+				}
+				stack.Item = item.ID
+			},
+			"quantity": func(n *yaml.Node) {
+				if quantity, ok := d.integer(n, "quantity", 1, 99); ok {
+					stack.Quantity = byte(quantity)
+				}
+			},
+		})
+		if resolve(n).Kind == yaml.MappingNode && d.lookup(n, "item") == nil {
+			d.errorf(n, "item is missing its name")
+		}
+		items = append(items, stack)
+	})
+	if len(items) > capacity {
+		d.errorf(n, "%s has %d items, the most it can hold is %d", what, len(items), capacity)
+	}
+	return items
+}
+
+// eventFlag decodes an event flag given by name, such as GOT_TOWN_MAP, or by number for flags without one.
+func (d *decoder) eventFlag(n *yaml.Node) (int, bool) {
+	if isInt(n) {
+		return d.integer(n, "event flag", 0, gamedata.EventFlagCount-1)
+	}
+
+	name, ok := d.str(n, "event flag")
+	if !ok {
+		return 0, false
+	}
+	flag, ok := gamedata.EventFlagByName(strings.ToUpper(name))
+	if !ok {
+		d.errorf(n, "unknown event flag %q", name)
+	}
+	return flag, ok
+}
+
+// missableObjects decodes a list of missable objects, by name or number, to hide or show.
+func (d *decoder) missableObjects(n *yaml.Node, field string, hidden bool, opts *pokegen.Options) {
+	d.sequence(n, field, func(n *yaml.Node) {
+		object, ok := d.missableObject(n, gamedata.Version(opts.Game))
+		if !ok {
+			return
+		}
+		if opts.MissableObjects == nil {
+			opts.MissableObjects = map[int]bool{}
+		}
+		opts.MissableObjects[object] = hidden
+	})
+}
+
+func (d *decoder) missableObject(n *yaml.Node, v gamedata.Version) (int, bool) {
+	if isInt(n) {
+		return d.integer(n, "missable object", 0, save.MissableObjectCount-1)
+	}
+
+	name, ok := d.str(n, "missable object")
+	if !ok {
+		return 0, false
+	}
+	object, ok := gamedata.MissableObjectByName(strings.ToUpper(name), v)
+	if !ok {
+		d.errorf(n, "unknown missable object %q", name)
+	}
+	return object, ok
+}
+
+// location decodes the player's location: a map given by name or number, and their coordinates on it.
+func (d *decoder) location(n *yaml.Node, opts *pokegen.Options) {
+	var location save.Location
+	d.fields(n, "location", map[string]func(*yaml.Node){
+		"map": func(n *yaml.Node) {
+			if isInt(n) {
+				if id, ok := d.integer(n, "map", 0, 0xFF); ok {
+					location.Map = byte(id)
+					if _, ok := gamedata.MapName(location.Map); !ok {
+						d.errorf(n, "unknown map 0x%02X", id)
+					}
+				}
+				return
+			}
+
+			name, ok := d.str(n, "map")
+			if !ok {
+				return
+			}
+			id, ok := gamedata.MapByName(name)
+			if !ok {
+				d.errorf(n, "unknown map %q", name)
+			}
+			location.Map = id
+		},
+		"x": func(n *yaml.Node) {
+			if x, ok := d.integer(n, "x", 0, 0xFF); ok {
+				location.X = byte(x)
+			}
+		},
+		"y": func(n *yaml.Node) {
+			if y, ok := d.integer(n, "y", 0, 0xFF); ok {
+				location.Y = byte(y)
+			}
+		},
+	})
+	if resolve(n).Kind == yaml.MappingNode && d.lookup(n, "map") == nil {
+		d.errorf(n, "location is missing its map")
+	}
+	opts.Location = &location
+}
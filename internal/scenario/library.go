@@ -0,0 +1,92 @@
+package scenario
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io/fs"
+	"pokegen/internal/pokegen"
+	"sort"
+	"strings"
+)
+
+//go:embed library/*.yaml
+var library embed.FS
+
+// Library holds the built-in scenarios, which other scenarios may extend by file name, such as elite-four.yaml.
+var Library = func() fs.FS {
+	sub, err := fs.Sub(library, "library")
+	if err != nil {
+		panic(err)
+	}
+	return sub
+}()
+
+// ErrUnknownPreset is returned when there is no built-in scenario with the name given.
+var ErrUnknownPreset = errors.New("unknown scenario")
+
+// Preset describes a built-in scenario. Its name is its file name without the .yaml extension.
+type Preset struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags"`
+}
+
+// Presets returns the built-in scenarios ordered by name.
+func Presets() ([]Preset, error) {
+	files, err := fs.Glob(Library, "*.yaml")
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	presets := make([]Preset, len(files))
+	for i, file := range files {
+		data, err := fs.ReadFile(Library, file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read scenario: %w", err)
+		}
+
+		var p struct {
+			Description string   `yaml:"description"`
+			Tags        []string `yaml:"tags"`
+		}
+		if err := yaml.Unmarshal(data, &p); err != nil {
+			return nil, syntaxError(file, err)
+		}
+
+		presets[i] = Preset{
+			Name:        strings.TrimSuffix(file, ".yaml"),
+			Description: p.Description,
+			Tags:        append([]string{}, p.Tags...),
+		}
+	}
+	return presets, nil
+}
+
+// LoadPreset returns the options the built-in scenario with the given name describes.
+// The scenario in overrides is applied on top of it, so it can change fields such as the player's name;
+// it cannot extend other scenarios, and may be empty.
+func LoadPreset(name string, overrides []byte) (pokegen.Options, error) {
+	presets, err := Presets()
+	if err != nil {
+		return pokegen.Options{}, err
+	}
+	known := false
+	for _, p := range presets {
+		known = known || p.Name == name
+	}
+	if !known {
+		return pokegen.Options{}, fmt.Errorf("%w %q", ErrUnknownPreset, name)
+	}
+
+	opts := defaults
+	if err := fsLoader(Library).load(&opts, name+".yaml", nil); err != nil {
+		return pokegen.Options{}, err
+	}
+	if err := (&loader{}).parse(&opts, overrides, "overrides", nil); err != nil {
+		return pokegen.Options{}, err
+	}
+	return opts, nil
+}
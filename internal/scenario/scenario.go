@@ -0,0 +1,181 @@
+// Package scenario loads scenario files: YAML or JSON documents describing an entire save to generate.
+//
+// A scenario may extend another with extends, naming its path relative to the extending scenario.
+// The extended scenario is applied first, then the fields the extending scenario sets replace its values,
+// except that boxes are replaced one box at a time, and flags and missable objects are added to those already set.
+package scenario
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"pokegen/internal/pokegen"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Error is a problem with a scenario, located by the scenario's name and the line and column within it.
+// Column is 0 when only the line is known.
+type Error struct {
+	File    string
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e Error) Error() string {
+	if e.Column == 0 {
+		return fmt.Sprintf("%s:%d: %s", e.File, e.Line, e.Message)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", e.File, e.Line, e.Column, e.Message)
+}
+
+// Errors holds every problem found in a scenario, in the order they appear.
+type Errors []Error
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+// Load reads the scenario called name from fsys, along with any scenarios it extends, and returns the options it describes.
+func Load(fsys fs.FS, name string) (pokegen.Options, error) {
+	opts := defaults
+	err := fsLoader(fsys).load(&opts, name, nil)
+	return opts, err
+}
+
+// LoadFile reads the scenario at the file path name, along with any scenarios it extends, and returns the options it describes.
+func LoadFile(name string) (pokegen.Options, error) {
+	l := &loader{
+		read: os.ReadFile,
+		join: func(name, extends string) string {
+			return filepath.Join(filepath.Dir(name), filepath.FromSlash(extends))
+		},
+	}
+
+	opts := defaults
+	err := l.load(&opts, name, nil)
+	return opts, err
+}
+
+// Parse parses a scenario that is not stored in fsys, such as one sent in a request, calling it name in errors.
+// Scenarios it extends are read from fsys, which may be nil when there are none to extend.
+func Parse(data []byte, name string, fsys fs.FS) (pokegen.Options, error) {
+	opts := defaults
+	var l *loader
+	if fsys != nil {
+		l = fsLoader(fsys)
+	} else {
+		l = &loader{}
+	}
+	err := l.parse(&opts, data, name, nil)
+	return opts, err
+}
+
+// defaults are the options scenarios start from: a new game of Red with the money the player starts with.
+var defaults = pokegen.Options{
+	Game:  pokegen.GameRed,
+	Money: 3000,
+}
+
+// loader reads scenarios, finding those extended by joining the extending scenario's name with the path it extends.
+// read is nil when there are no scenarios to extend.
+type loader struct {
+	read func(name string) ([]byte, error)
+	join func(name, extends string) string
+}
+
+func fsLoader(fsys fs.FS) *loader {
+	return &loader{
+		read: func(name string) ([]byte, error) {
+			return fs.ReadFile(fsys, name)
+		},
+		join: func(name, extends string) string {
+			return path.Join(path.Dir(name), extends)
+		},
+	}
+}
+
+// load reads the scenario called name and applies it to opts.
+// chain holds the scenarios extending it, so a scenario that ends up extending itself is reported.
+func (l *loader) load(opts *pokegen.Options, name string, chain []string) error {
+	data, err := l.read(name)
+	if err != nil {
+		return fmt.Errorf("failed to read scenario: %w", err)
+	}
+	return l.parse(opts, data, name, chain)
+}
+
+func (l *loader) parse(opts *pokegen.Options, data []byte, name string, chain []string) error {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return syntaxError(name, err)
+	}
+	if len(doc.Content) == 0 {
+		// An empty scenario describes the default save.
+		return nil
+	}
+	root := doc.Content[0]
+
+	d := &decoder{file: name}
+	if extends := d.lookup(root, "extends"); extends != nil {
+		if err := l.extend(opts, d, extends, append(chain, name)); err != nil {
+			return err
+		}
+	}
+
+	d.scenario(root, opts)
+	if len(d.errs) > 0 {
+		return d.errs
+	}
+	return nil
+}
+
+// extend applies the scenario named by the extends field to opts.
+func (l *loader) extend(opts *pokegen.Options, d *decoder, n *yaml.Node, chain []string) error {
+	extends, ok := d.str(n, "extends")
+	if !ok {
+		return d.errs
+	}
+	if l.read == nil {
+		d.errorf(n, "cannot extend %q: there are no scenarios to extend here", extends)
+		return d.errs
+	}
+
+	name := l.join(chain[len(chain)-1], extends)
+	for _, extending := range chain {
+		if extending == name {
+			d.errorf(n, "cannot extend %q: scenarios cannot extend themselves, but %s", extends, strings.Join(append(chain, name), " extends "))
+			return d.errs
+		}
+	}
+
+	err := l.load(opts, name, chain)
+	if errors.Is(err, fs.ErrNotExist) || errors.Is(err, fs.ErrInvalid) {
+		d.errorf(n, "cannot extend %q: no such scenario", extends)
+		return d.errs
+	}
+	return err
+}
+
+// yamlLine matches the line yaml reports syntax errors on.
+var yamlLine = regexp.MustCompile(`^yaml: line (\d+): (.*)$`)
+
+// syntaxError converts the error yaml returns for a document it cannot parse into an Error.
+func syntaxError(name string, err error) error {
+	m := yamlLine.FindStringSubmatch(err.Error())
+	if m == nil {
+		return Errors{{File: name, Line: 1, Message: strings.TrimPrefix(err.Error(), "yaml: ")}}
+	}
+	line, _ := strconv.Atoi(m[1])
+	return Errors{{File: name, Line: line, Message: m[2]}}
+}
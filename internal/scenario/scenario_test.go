@@ -0,0 +1,230 @@
+package scenario_test
+
+import (
+	"github.com/stretchr/testify/assert"
+	"pokegen/internal/pokegen"
+	"pokegen/internal/save"
+	"pokegen/internal/scenario"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoad(t *testing.T) {
+	fsys := fstest.MapFS{"gym.yaml": {Data: []byte(`
+game: blue
+trainer:
+  name: ASH
+  rival: GARY
+  money: 0x1000
+  badges: [Boulder, CASCADEBADGE]
+party:
+  - species: Pikachu
+    level: 25
+    moves: [Thunderbolt, Quick Attack]
+    nickname: SPARKY
+    dvs: {attack: 15, defense: 14, speed: 13, special: 12}
+boxes:
+  2:
+    - species: Mr. Mime
+      level: 30
+      ot_name: TRAINER
+      ot_id: 12345
+items:
+  bag:
+    - item: Poke Ball
+      quantity: 5
+    - item: TM24
+  pc: []
+flags: [GOT_TOWN_MAP, 0x27]
+missables: {hide: [Town_Map, 0x2F], show: [OLD_MAN]}
+location: {map: Viridian City, x: 5, y: 6}
+reconcile: true
+`)}}
+
+	opts, err := scenario.Load(fsys, "gym.yaml")
+	assert.NoError(t, err)
+
+	id := uint16(12345)
+	assert.Equal(t, pokegen.Options{
+		Game:       pokegen.GameBlue,
+		PlayerName: "ASH",
+		RivalName:  "GARY",
+		Money:      0x1000,
+		Badges:     0x03,
+		Party: []pokegen.Pokemon{{
+			Species:  0x54,
+			Level:    25,
+			Moves:    []byte{0x55, 0x62},
+			DVs:      save.Stats{Attack: 15, Defense: 14, Speed: 13, Special: 12},
+			Nickname: "SPARKY",
+		}},
+		Boxes: map[int][]pokegen.Pokemon{
+			1: {{Species: 0x2A, Level: 30, OTName: "TRAINER", OTID: &id}},
+		},
+		Bag:             []save.ItemStack{{Item: 0x04, Quantity: 5}, {Item: 0xE0, Quantity: 1}},
+		PCItems:         []save.ItemStack{},
+		Events:          []int{0x018, 0x027},
+		MissableObjects: map[int]bool{0x29: true, 0x2F: true, 0x02: false},
+		Location:        &save.Location{Map: 0x01, X: 5, Y: 6},
+		Reconcile:       true,
+	}, opts)
+}
+
+func TestLoad_JSON(t *testing.T) {
+	fsys := fstest.MapFS{"scenario.json": {Data: []byte(`{
+  "game": "red",
+  "starter": "squirtle",
+  "trainer": {"name": "RED", "money": 500}
+}`)}}
+
+	opts, err := scenario.Load(fsys, "scenario.json")
+	assert.NoError(t, err)
+	assert.Equal(t, pokegen.Options{
+		Game:       pokegen.GameRed,
+		Starter:    pokegen.StarterSquirtle,
+		PlayerName: "RED",
+		Money:      500,
+	}, opts)
+}
+
+func TestParse_Empty(t *testing.T) {
+	opts, err := scenario.Parse(nil, "request", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, pokegen.Options{Game: pokegen.GameRed, Money: 3000}, opts)
+}
+
+func TestLoad_Extends(t *testing.T) {
+	fsys := fstest.MapFS{
+		"base.yaml": {Data: []byte(`
+game: red
+trainer: {name: RED, money: 3000}
+party:
+  - {species: Bulbasaur, level: 5}
+boxes:
+  1: [{species: Rattata, level: 3}]
+  2: [{species: Pidgey, level: 3}]
+flags: [GOT_STARTER]
+missables: {hide: [POKEDEX_1]}
+`)},
+		"gyms/brock.yaml": {Data: []byte(`
+extends: ../base.yaml
+trainer: {money: 100}
+party:
+  - {species: Ivysaur, level: 16}
+boxes:
+  2: []
+flags: [BEAT_BROCK]
+missables: {hide: [POKEDEX_2]}
+`)},
+	}
+
+	opts, err := scenario.Load(fsys, "gyms/brock.yaml")
+	assert.NoError(t, err)
+	assert.Equal(t, pokegen.GameRed, opts.Game)
+	assert.Equal(t, "RED", opts.PlayerName, "name should be kept from the extended scenario")
+	assert.Equal(t, uint64(100), opts.Money)
+	assert.Equal(t, []pokegen.Pokemon{{Species: 0x09, Level: 16}}, opts.Party)
+	assert.Equal(t, map[int][]pokegen.Pokemon{
+		0: {{Species: 0xA5, Level: 3}},
+		1: {},
+	}, opts.Boxes)
+	assert.Equal(t, []int{0x022, 0x077}, opts.Events)
+	assert.Equal(t, map[int]bool{0x2F: true, 0x30: true}, opts.MissableObjects)
+}
+
+func TestParse_YellowMissables(t *testing.T) {
+	// Missable objects are numbered by the game, wherever it is set.
+	opts, err := scenario.Parse([]byte("missables: {hide: [EEVEE_BALL, POKEDEX_1]}\ngame: yellow\n"), "request", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]bool{0x2B: true, 0x2D: true}, opts.MissableObjects)
+
+	_, err = scenario.Parse([]byte("game: red\nmissables: {hide: [EEVEE_BALL]}\n"), "request", nil)
+	assert.EqualError(t, err, `request:2:20: unknown missable object "EEVEE_BALL"`)
+}
+
+func TestLoad_ExtendsItself(t *testing.T) {
+	fsys := fstest.MapFS{
+		"a.yaml": {Data: []byte("extends: b.yaml\n")},
+		"b.yaml": {Data: []byte("game: red\nextends: a.yaml\n")},
+	}
+
+	_, err := scenario.Load(fsys, "a.yaml")
+	assert.EqualError(t, err, `b.yaml:2:10: cannot extend "a.yaml": scenarios cannot extend themselves, but a.yaml extends b.yaml extends a.yaml`)
+}
+
+func TestParse_Errors(t *testing.T) {
+	for _, test := range []struct {
+		scenario string
+		err      string
+	}{
+		{"game: green\n", `request:1:7: unknown game "green", want red, blue or yellow`},
+		{"money: 100\n", `request:1:1: unknown field "money" in scenario`},
+		{"trainer:\n  money: 1000000\n", "request:2:10: money 1000000 is not between 0 and 999999"},
+		{"party:\n  - species: Pikachoo\n    level: 5\n", `request:2:14: unknown species "Pikachoo"`},
+		{"party:\n  - species: Pikachu\n", "request:2:5: pokémon is missing its level"},
+		{"party:\n  - {species: Pikachu, level: 5, moves: [Thunderbolt, Splash, Surf, Cut, Fly]}\n", "request:2:41: got 5 moves, want at most 4"},
+		{"boxes:\n  13: []\n", "request:2:3: box 13 is not between 1 and 12"},
+		{"items:\n  bag: [{item: Potion, quantity: 100}]\n", "request:2:34: quantity 100 is not between 1 and 99"},
+		{"flags: [GOT_EVERYTHING]\n", `request:1:9: unknown event flag "GOT_EVERYTHING"`},
+		{"missables: {hide: [SNORLAX_1]}\n", `request:1:20: unknown missable object "SNORLAX_1"`},
+		{"missables: {hide: [0x100]}\n", "request:1:20: missable object 256 is not between 0 and 255"},
+		{"location: {x: 1, y: 2}\n", "request:1:11: location is missing its map"},
+		{"reconcile: yes\n", "request:1:12: reconcile must be true or false"},
+		{"extends: base.yaml\n", `request:1:10: cannot extend "base.yaml": there are no scenarios to extend here`},
+		{"game: red\ngame: blue\n", `request:2:1: field "game" is set twice in scenario`},
+		{"party: [\n", "request:1: did not find expected node content"},
+	} {
+		_, err := scenario.Parse([]byte(test.scenario), "request", nil)
+		assert.EqualError(t, err, test.err, test.scenario)
+	}
+}
+
+func TestParse_EveryError(t *testing.T) {
+	_, err := scenario.Parse([]byte("game: green\nlanguage: xx\n"), "request", nil)
+
+	var errs scenario.Errors
+	assert.ErrorAs(t, err, &errs)
+	assert.Equal(t, scenario.Errors{
+		{File: "request", Line: 1, Column: 7, Message: `unknown game "green", want red, blue or yellow`},
+		{File: "request", Line: 2, Column: 11, Message: `unknown language "xx"`},
+	}, errs)
+}
+
+func TestPresets(t *testing.T) {
+	presets, err := scenario.Presets()
+	assert.NoError(t, err)
+	assert.NotEmpty(t, presets)
+
+	for _, p := range presets {
+		assert.NotEmpty(t, p.Description, p.Name)
+		assert.NotEmpty(t, p.Tags, p.Name)
+
+		// Every preset should generate a save holding nothing the legality checker calls impossible.
+		opts, err := scenario.LoadPreset(p.Name, nil)
+		assert.NoError(t, err, p.Name)
+		findings, err := pokegen.CheckLegality(opts)
+		assert.NoError(t, err, p.Name)
+		assert.Empty(t, findings, p.Name)
+	}
+}
+
+func TestLoadPreset_Overrides(t *testing.T) {
+	opts, err := scenario.LoadPreset("nuzlocke-start", []byte("trainer: {name: ASH, rival: GARY}\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, "ASH", opts.PlayerName)
+	assert.Equal(t, "GARY", opts.RivalName)
+	assert.Equal(t, pokegen.StarterCharmander, opts.Starter, "starter should be kept from the preset")
+
+	_, err = scenario.LoadPreset("nuzlocke-start", []byte("extends: elite-four.yaml\n"))
+	assert.EqualError(t, err, `overrides:1:10: cannot extend "elite-four.yaml": there are no scenarios to extend here`)
+
+	_, err = scenario.LoadPreset("../scenario", nil)
+	assert.ErrorIs(t, err, scenario.ErrUnknownPreset)
+}
+
+func TestParse_ExtendsLibrary(t *testing.T) {
+	opts, err := scenario.Parse([]byte("extends: elite-four.yaml\ntrainer: {name: ASH}\n"), "request", scenario.Library)
+	assert.NoError(t, err)
+	assert.Equal(t, "ASH", opts.PlayerName)
+	assert.Equal(t, byte(0xFF), opts.Badges)
+}
@@ -0,0 +1,158 @@
+package showdown
+
+import (
+	"fmt"
+	"pokegen/internal/gamedata"
+	"pokegen/internal/save"
+	"pokegen/internal/util"
+	"strings"
+)
+
+// Format writes Pokémon as Showdown team text, with a blank line after each set.
+// Values that match Showdown's defaults are left out, as Showdown does.
+func Format(pokemon []save.Pokemon) (string, error) {
+	var b strings.Builder
+	for i, p := range pokemon {
+		if err := formatSet(&b, p); err != nil {
+			return "", fmt.Errorf("pokémon %d: %w", i+1, err)
+		}
+		b.WriteString("\n")
+	}
+	return b.String(), nil
+}
+
+// Export writes the party of a save as Showdown team text and, if boxes is set, each box that holds Pokémon.
+// Each team is preceded by a header naming where it came from, such as "=== [gen1] Box 3 ===".
+func Export(f *save.File, boxes bool) (string, error) {
+	var b strings.Builder
+
+	party, err := f.Party()
+	if err != nil {
+		return "", fmt.Errorf("party: %w", err)
+	}
+	if err := writeTeam(&b, "Party", party); err != nil {
+		return "", fmt.Errorf("party: %w", err)
+	}
+
+	if !boxes {
+		return b.String(), nil
+	}
+
+	for n := 0; n < f.Layout().Boxes; n++ {
+		box, err := f.Box(n)
+		if err != nil {
+			return "", fmt.Errorf("box %d: %w", n+1, err)
+		}
+		if len(box) == 0 {
+			continue
+		}
+
+		// Pokémon in boxes have no stats stored, so they are calculated as the game does when withdrawing them.
+		for i := range box {
+			if err := box[i].UpdateStats(); err != nil {
+				return "", fmt.Errorf("box %d pokémon %d: %w", n+1, i+1, err)
+			}
+		}
+		if err := writeTeam(&b, fmt.Sprintf("Box %d", n+1), box); err != nil {
+			return "", fmt.Errorf("box %d: %w", n+1, err)
+		}
+	}
+
+	return b.String(), nil
+}
+
+func writeTeam(b *strings.Builder, name string, team []save.Pokemon) error {
+	text, err := Format(team)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(b, "=== [gen1] %s ===\n\n%s", name, text)
+	return nil
+}
+
+func formatSet(b *strings.Builder, p save.Pokemon) error {
+	s, ok := gamedata.SpeciesByIndex(p.Species)
+	if !ok {
+		return fmt.Errorf("unknown species 0x%02X", p.Species)
+	}
+
+	if p.Nickname != "" && p.Nickname != s.Name {
+		fmt.Fprintf(b, "%s (%s)\n", util.DisplayText(p.Nickname), speciesName(s))
+	} else {
+		fmt.Fprintf(b, "%s\n", speciesName(s))
+	}
+
+	if p.Level != defaultLevel {
+		fmt.Fprintf(b, "Level: %d\n", p.Level)
+	}
+
+	dvs := p.DV()
+	writeStats(b, "EVs", defaultEV, save.Stats{
+		HP:      statExpToEV(p.StatExp.HP),
+		Attack:  statExpToEV(p.StatExp.Attack),
+		Defense: statExpToEV(p.StatExp.Defense),
+		Speed:   statExpToEV(p.StatExp.Speed),
+		Special: statExpToEV(p.StatExp.Special),
+	})
+	// The HP DV is left out as Showdown derives it from the others, as the game does.
+	// DVs are doubled to give IVs, with the maximum DV written as Showdown's default IV of 31.
+	writeStats(b, "IVs", defaultIV, save.Stats{
+		HP:      defaultIV,
+		Attack:  dvToIV(dvs.Attack),
+		Defense: dvToIV(dvs.Defense),
+		Speed:   dvToIV(dvs.Speed),
+		Special: dvToIV(dvs.Special),
+	})
+
+	for _, id := range p.Moves {
+		if id == 0 {
+			continue
+		}
+		m, ok := gamedata.MoveByID(id)
+		if !ok {
+			return fmt.Errorf("unknown move 0x%02X", id)
+		}
+		fmt.Fprintf(b, "- %s\n", moveName(m))
+	}
+
+	return nil
+}
+
+// writeStats writes a line such as "EVs: 0 HP / 128 Atk" listing the stats that differ from def, if any do.
+func writeStats(b *strings.Builder, label string, def uint16, stats save.Stats) {
+	var parts []string
+	for _, stat := range []struct {
+		name  string
+		value uint16
+	}{
+		{"HP", stats.HP},
+		{"Atk", stats.Attack},
+		{"Def", stats.Defense},
+		{"SpA", stats.Special},
+		{"Spe", stats.Speed},
+	} {
+		if stat.value != def {
+			parts = append(parts, fmt.Sprintf("%d %s", stat.value, stat.name))
+		}
+	}
+
+	if len(parts) > 0 {
+		fmt.Fprintf(b, "%s: %s\n", label, strings.Join(parts, " / "))
+	}
+}
+
+// statExpToEV converts stat experience to the EV Showdown uses in its place: the rounded up square root, which is what the stat formula uses.
+func statExpToEV(statExp uint16) uint16 {
+	var ev uint16
+	for ev < 255 && int(ev)*int(ev) < int(statExp) {
+		ev++
+	}
+	return ev
+}
+
+func dvToIV(dv uint16) uint16 {
+	if dv == defaultDV {
+		return defaultIV
+	}
+	return dv * 2
+}
@@ -0,0 +1,58 @@
+package showdown_test
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"pokegen/internal/pokegen"
+	"pokegen/internal/save"
+	"pokegen/internal/showdown"
+	"testing"
+)
+
+func TestExport(t *testing.T) {
+	party, err := showdown.Parse(team)
+	assert.NoError(t, err)
+
+	buf := new(bytes.Buffer)
+	err = pokegen.Gen(buf, pokegen.Options{Game: pokegen.GameRed, Money: 3000, Party: party})
+	assert.NoError(t, err)
+
+	f, err := save.Load(buf.Bytes())
+	assert.NoError(t, err)
+
+	text, err := showdown.Export(f, true)
+	assert.NoError(t, err)
+	assert.Equal(t, `=== [gen1] Party ===
+
+Tauros
+- Body Slam
+- Hyper Beam
+- Blizzard
+- Earthquake
+
+Sparky (Pikachu)
+Level: 50
+EVs: 100 HP / 0 SpA
+IVs: 20 Def
+- Thunder Shock
+- Thunder Wave
+
+`, text)
+
+	reimported, err := showdown.Parse(text)
+	assert.NoError(t, err)
+	assert.Equal(t, party, reimported)
+}
+
+func TestFormat_RenamedMovesAndSpecies(t *testing.T) {
+	text, err := showdown.Format([]save.Pokemon{{
+		Species:  0x2A,
+		Level:    100,
+		Moves:    [4]byte{0x88, 0x0B},
+		DVs:      0xFFFF,
+		StatExp:  save.Stats{HP: 63504, Attack: 63504, Defense: 63504, Speed: 63504, Special: 63504},
+		Nickname: "MR.MIME",
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, "Mr. Mime\n- High Jump Kick\n- Vise Grip\n\n", text)
+}
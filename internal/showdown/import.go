@@ -0,0 +1,255 @@
+// Package showdown converts between Gen 1 Pokémon and Pokémon Showdown's team text format.
+package showdown
+
+import (
+	"bufio"
+	"fmt"
+	"pokegen/internal/gamedata"
+	"pokegen/internal/pokegen"
+	"pokegen/internal/save"
+	"strconv"
+	"strings"
+)
+
+// Problem is something in a team that cannot be represented in Gen 1.
+type Problem struct {
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("line %d: %s", p.Line, p.Message)
+}
+
+// Error is returned when a team has problems, listing every problem found.
+type Error struct {
+	Problems []Problem
+}
+
+func (e *Error) Error() string {
+	messages := make([]string, len(e.Problems))
+	for i, p := range e.Problems {
+		messages[i] = p.String()
+	}
+	return "invalid team: " + strings.Join(messages, "; ")
+}
+
+// Defaults follow Showdown, which assumes a Pokémon at level 100 with maximum DVs and stat experience unless told otherwise.
+const (
+	defaultLevel = 100
+	defaultDV    = 15
+	defaultIV    = 31
+	defaultEV    = 252
+)
+
+// Parse converts Gen 1 Showdown team text into Pokémon.
+// Sets are separated by blank lines, and team headers such as "=== [gen1ou] Team ===" are skipped.
+// IVs are halved to give DVs, as Showdown does, and EVs are squared to give stat experience.
+func Parse(text string) ([]pokegen.Pokemon, error) {
+	p := parser{}
+
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	for line := 1; scanner.Scan(); line++ {
+		p.line = line
+		p.parseLine(strings.TrimSpace(scanner.Text()))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read team: %w", err)
+	}
+	p.finishSet()
+
+	if len(p.problems) > 0 {
+		return nil, &Error{Problems: p.problems}
+	}
+	return p.team, nil
+}
+
+type parser struct {
+	line     int
+	problems []Problem
+	team     []pokegen.Pokemon
+
+	current *pokegen.Pokemon
+	start   int
+	hpDV    *int
+}
+
+func (p *parser) problem(format string, args ...any) {
+	p.problemAt(p.line, format, args...)
+}
+
+func (p *parser) problemAt(line int, format string, args ...any) {
+	p.problems = append(p.problems, Problem{Line: line, Message: fmt.Sprintf(format, args...)})
+}
+
+func (p *parser) parseLine(line string) {
+	switch {
+	case line == "":
+		p.finishSet()
+	case strings.HasPrefix(line, "==="):
+		p.finishSet()
+	case p.current == nil:
+		p.startSet(line)
+	case strings.HasPrefix(line, "- "):
+		p.parseMove(strings.TrimSpace(strings.TrimPrefix(line, "- ")))
+	case strings.HasPrefix(line, "Level:"):
+		level, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "Level:")))
+		if err != nil || level < 1 || level > 100 {
+			p.problem("level must be a number between 1 and 100")
+			return
+		}
+		p.current.Level = level
+	case strings.HasPrefix(line, "EVs:"):
+		p.parseStats(strings.TrimPrefix(line, "EVs:"), 255, func(stat string, ev int) {
+			// 255 squared is the largest value, which still fits in the two bytes stat experience is stored in.
+			setStat(&p.current.StatExp, stat, uint16(ev*ev))
+		})
+	case strings.HasPrefix(line, "IVs:"):
+		p.parseStats(strings.TrimPrefix(line, "IVs:"), 31, func(stat string, iv int) {
+			p.setDV(stat, iv/2)
+		})
+	case strings.HasPrefix(line, "DVs:"):
+		p.parseStats(strings.TrimPrefix(line, "DVs:"), 15, p.setDV)
+	case line == "Ability: No Ability":
+	case strings.HasPrefix(line, "Ability:"):
+		p.problem("abilities do not exist in Gen 1")
+	case strings.HasSuffix(line, " Nature"):
+		p.problem("natures do not exist in Gen 1")
+	case strings.HasPrefix(line, "Shiny:"):
+		p.problem("shiny Pokémon do not exist in Gen 1")
+	case strings.HasPrefix(line, "Happiness:"):
+		p.problem("happiness does not exist in Gen 1")
+	case strings.HasPrefix(line, "Tera Type:"):
+		p.problem("tera types do not exist in Gen 1")
+	default:
+		p.problem("unrecognised line %q", line)
+	}
+}
+
+// startSet parses the first line of a set: "Nickname (Species) (M) @ Item".
+func (p *parser) startSet(line string) {
+	p.current = &pokegen.Pokemon{
+		Level: defaultLevel,
+		DVs:   save.Stats{Attack: defaultDV, Defense: defaultDV, Speed: defaultDV, Special: defaultDV},
+		StatExp: save.Stats{
+			HP:      defaultEV * defaultEV,
+			Attack:  defaultEV * defaultEV,
+			Defense: defaultEV * defaultEV,
+			Speed:   defaultEV * defaultEV,
+			Special: defaultEV * defaultEV,
+		},
+	}
+	p.start = p.line
+	p.hpDV = nil
+
+	if name, item, found := strings.Cut(line, "@"); found {
+		line = strings.TrimSpace(name)
+		p.problem("held items do not exist in Gen 1, got %q", strings.TrimSpace(item))
+	}
+
+	// Gen 1 has no genders, but Showdown may still write one.
+	line = strings.TrimSuffix(strings.TrimSuffix(line, " (M)"), " (F)")
+
+	name := line
+	if open := strings.LastIndex(line, " ("); open >= 0 && strings.HasSuffix(line, ")") {
+		p.current.Nickname = strings.TrimSpace(line[:open])
+		name = line[open+2 : len(line)-1]
+	}
+
+	s, ok := gamedata.SpeciesByName(name)
+	if !ok {
+		p.problem("species %q does not exist in Gen 1", name)
+		return
+	}
+	p.current.Species = s.Index
+}
+
+func (p *parser) parseMove(name string) {
+	m, ok := lookupMove(name)
+	if !ok {
+		p.problem("move %q does not exist in Gen 1", name)
+		return
+	}
+	if len(p.current.Moves) == 4 {
+		p.problem("a Pokémon can only know 4 moves")
+		return
+	}
+	p.current.Moves = append(p.current.Moves, m.ID)
+}
+
+// parseStats parses a list of stat values such as "252 HP / 252 Atk".
+func (p *parser) parseStats(list string, limit int, set func(stat string, value int)) {
+	for _, part := range strings.Split(list, "/") {
+		fields := strings.Fields(part)
+		if len(fields) != 2 {
+			p.problem("stat %q must be a value followed by a stat name", strings.TrimSpace(part))
+			continue
+		}
+
+		value, err := strconv.Atoi(fields[0])
+		if err != nil || value < 0 || value > limit {
+			p.problem("%s must be a number between 0 and %d", fields[1], limit)
+			continue
+		}
+
+		stat, ok := statNames[fields[1]]
+		if !ok {
+			p.problem("unknown stat %q", fields[1])
+			continue
+		}
+		set(stat, value)
+	}
+}
+
+// statNames maps Showdown's stat names to Gen 1 stats. Gen 1 has a single Special stat which Showdown writes as SpA and SpD.
+var statNames = map[string]string{
+	"HP":  "HP",
+	"Atk": "Attack",
+	"Def": "Defense",
+	"Spe": "Speed",
+	"SpA": "Special",
+	"SpD": "Special",
+	"Spc": "Special",
+}
+
+func (p *parser) setDV(stat string, dv int) {
+	if stat == "HP" {
+		p.hpDV = &dv
+		return
+	}
+	setStat(&p.current.DVs, stat, uint16(dv))
+}
+
+func setStat(stats *save.Stats, stat string, value uint16) {
+	switch stat {
+	case "HP":
+		stats.HP = value
+	case "Attack":
+		stats.Attack = value
+	case "Defense":
+		stats.Defense = value
+	case "Speed":
+		stats.Speed = value
+	case "Special":
+		stats.Special = value
+	}
+}
+
+func (p *parser) finishSet() {
+	if p.current == nil {
+		return
+	}
+
+	if p.hpDV != nil {
+		derived := save.Pokemon{DVs: save.PackDVs(p.current.DVs)}.DV().HP
+		if uint16(*p.hpDV) != derived {
+			p.problemAt(p.start, "HP DV %d does not match %d, derived from the other DVs", *p.hpDV, derived)
+		}
+	}
+	if len(p.team) == 6 {
+		p.problemAt(p.start, "a party can only hold 6 Pokémon")
+	}
+
+	p.team = append(p.team, *p.current)
+	p.current = nil
+}
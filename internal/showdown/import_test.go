@@ -0,0 +1,70 @@
+package showdown_test
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"pokegen/internal/gamedata"
+	"pokegen/internal/save"
+	"pokegen/internal/showdown"
+	"testing"
+)
+
+const team = `=== [gen1ou] Untitled ===
+
+Tauros
+- Body Slam
+- Hyper Beam
+- Blizzard
+- Earthquake
+
+Sparky (Pikachu) (M)
+Level: 50
+EVs: 100 HP / 0 SpA
+IVs: 30 Atk / 20 Def / 31 Spe
+- ThunderShock
+- Thunder Wave
+`
+
+func TestParse(t *testing.T) {
+	party, err := showdown.Parse(team)
+	assert.NoError(t, err)
+	assert.Len(t, party, 2)
+
+	tauros, _ := gamedata.SpeciesByName("TAUROS")
+	assert.Equal(t, tauros.Index, party[0].Species)
+	assert.Equal(t, 100, party[0].Level)
+	assert.Equal(t, []byte{0x22, 0x3F, 0x3B, 0x59}, party[0].Moves)
+	assert.Equal(t, save.Stats{Attack: 15, Defense: 15, Speed: 15, Special: 15}, party[0].DVs)
+	assert.Equal(t, uint16(63504), party[0].StatExp.HP)
+
+	assert.Equal(t, "Sparky", party[1].Nickname)
+	assert.Equal(t, 50, party[1].Level)
+	assert.Equal(t, save.Stats{Attack: 15, Defense: 10, Speed: 15, Special: 15}, party[1].DVs)
+	assert.Equal(t, save.Stats{HP: 10000, Attack: 63504, Defense: 63504, Speed: 63504, Special: 0}, party[1].StatExp)
+	assert.Equal(t, []byte{0x54, 0x56}, party[1].Moves)
+}
+
+func TestParse_NotInGen1(t *testing.T) {
+	_, err := showdown.Parse(`Togepi @ Leftovers
+Ability: Hustle
+Adamant Nature
+- Metronome
+- Hidden Power [Fire]`)
+
+	var showdownErr *showdown.Error
+	assert.True(t, errors.As(err, &showdownErr))
+	assert.Equal(t, []showdown.Problem{
+		{Line: 1, Message: `held items do not exist in Gen 1, got "Leftovers"`},
+		{Line: 1, Message: `species "Togepi" does not exist in Gen 1`},
+		{Line: 2, Message: "abilities do not exist in Gen 1"},
+		{Line: 3, Message: "natures do not exist in Gen 1"},
+		{Line: 5, Message: `move "Hidden Power [Fire]" does not exist in Gen 1`},
+	}, showdownErr.Problems)
+}
+
+func TestParse_HPDVMismatch(t *testing.T) {
+	_, err := showdown.Parse(`Mew
+DVs: 14 HP / 15 Atk
+- Psychic`)
+	assert.ErrorContains(t, err, "line 1: HP DV 14 does not match 15")
+}
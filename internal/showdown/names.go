@@ -0,0 +1,68 @@
+package showdown
+
+import (
+	"pokegen/internal/gamedata"
+	"strings"
+)
+
+// speciesNames holds the Showdown names of species whose Gen 1 names cannot be converted by changing case.
+var speciesNames = map[byte]string{
+	0x0F: "Nidoran-F",
+	0x03: "Nidoran-M",
+	0x40: "Farfetch’d",
+	0x2A: "Mr. Mime",
+}
+
+// moveNames holds the Showdown names of moves that were renamed or respaced after Gen 1.
+var moveNames = map[byte]string{
+	0x03: "Double Slap",
+	0x09: "Thunder Punch",
+	0x0B: "Vise Grip",
+	0x1C: "Sand Attack",
+	0x31: "Sonic Boom",
+	0x3D: "Bubble Beam",
+	0x4C: "Solar Beam",
+	0x4D: "Poison Powder",
+	0x54: "Thunder Shock",
+	0x78: "Self-Destruct",
+	0x87: "Soft-Boiled",
+	0x88: "High Jump Kick",
+}
+
+func speciesName(s gamedata.Species) string {
+	if name, ok := speciesNames[s.Index]; ok {
+		return name
+	}
+	return titleCase(s.Name)
+}
+
+func moveName(m gamedata.Move) string {
+	if name, ok := moveNames[m.ID]; ok {
+		return name
+	}
+	return titleCase(m.Name)
+}
+
+// lookupMove finds a move by its Gen 1 or Showdown name.
+func lookupMove(name string) (gamedata.Move, bool) {
+	if m, ok := gamedata.MoveByName(name); ok {
+		return m, true
+	}
+	for id, showdownName := range moveNames {
+		if gamedata.NormaliseName(showdownName) == gamedata.NormaliseName(name) {
+			return gamedata.MoveByID(id)
+		}
+	}
+	return gamedata.Move{}, false
+}
+
+// titleCase converts an upper case Gen 1 name such as "DOUBLE-EDGE" to "Double-Edge".
+func titleCase(name string) string {
+	b := []rune(strings.ToLower(name))
+	for i := range b {
+		if i == 0 || b[i-1] == ' ' || b[i-1] == '-' {
+			b[i] = []rune(strings.ToUpper(string(b[i])))[0]
+		}
+	}
+	return string(b)
+}
@@ -0,0 +1,195 @@
+package util
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// Charset maps the runes a game can display to the bytes it stores them as.
+type Charset map[rune]byte
+
+// Trainer stands for the control character 0x5D, which the games display as their word for trainer, such as TRAINER.
+// It is a private use rune, so it is never mistaken for the letters it displays as.
+const Trainer = '\uE05D'
+
+// DisplayText returns text as the games show it, with Trainer written out as TRAINER.
+// It is for showing text only: text written to a save must keep Trainer, which is a single byte.
+func DisplayText(text string) string {
+	return strings.ReplaceAll(text, string(Trainer), "TRAINER")
+}
+
+// English is the Gen 1 US English character set.
+var English = Charset{
+	'A': 0x80, 'B': 0x81, 'C': 0x82, 'D': 0x83, 'E': 0x84, 'F': 0x85,
+	'G': 0x86, 'H': 0x87, 'I': 0x88, 'J': 0x89, 'K': 0x8A, 'L': 0x8B,
+	'M': 0x8C, 'N': 0x8D, 'O': 0x8E, 'P': 0x8F, 'Q': 0x90, 'R': 0x91,
+	'S': 0x92, 'T': 0x93, 'U': 0x94, 'V': 0x95, 'W': 0x96, 'X': 0x97,
+	'Y': 0x98, 'Z': 0x99, '(': 0x9A, ')': 0x9B, ':': 0x9C, ';': 0x9D,
+	'[': 0x9E, ']': 0x9F,
+
+	'a': 0xA0, 'b': 0xA1, 'c': 0xA2, 'd': 0xA3, 'e': 0xA4, 'f': 0xA5,
+	'g': 0xA6, 'h': 0xA7, 'i': 0xA8, 'j': 0xA9, 'k': 0xAA, 'l': 0xAB,
+	'm': 0xAC, 'n': 0xAD, 'o': 0xAE, 'p': 0xAF, 'q': 0xB0, 'r': 0xB1,
+	's': 0xB2, 't': 0xB3, 'u': 0xB4, 'v': 0xB5, 'w': 0xB6, 'x': 0xB7,
+	'y': 0xB8, 'z': 0xB9,
+
+	//'PK': 0x??, 'MN': 0x??,
+
+	'\'': 0xE0, '-': 0xE3,
+	'?': 0xE6, '!': 0xE7, '.': 0xE8,
+	'♂': 0xEF,
+	'/': 0xF3, ',': 0xF4, '♀': 0xF5,
+
+	Trainer: 0x5D,
+}
+
+// French is the Gen 1 French character set: the English letters with the accented letters used in French and German.
+var French = English.with(Charset{
+	'à': 0xBA, 'è': 0xBB, 'é': 0xBC, 'ù': 0xBD, 'ß': 0xBE, 'ç': 0xBF,
+	'Ä': 0xC0, 'Ö': 0xC1, 'Ü': 0xC2, 'ä': 0xC3, 'ö': 0xC4, 'ü': 0xC5,
+	'ë': 0xC6, 'ï': 0xC7, 'â': 0xC8, 'ô': 0xC9, 'û': 0xCA, 'ê': 0xCB,
+	'î': 0xCC,
+})
+
+// German is the Gen 1 German character set, which is shared with the French releases.
+var German = French
+
+// Italian is the Gen 1 Italian character set: the English letters with the accented letters used in Italian and Spanish.
+var Italian = English.with(Charset{
+	'à': 0xBA, 'è': 0xBB, 'é': 0xBC, 'ù': 0xBD, 'À': 0xBE, 'Á': 0xBF,
+	'Ä': 0xC0, 'Ö': 0xC1, 'Ü': 0xC2, 'ä': 0xC3, 'ö': 0xC4, 'ü': 0xC5,
+	'È': 0xC6, 'É': 0xC7, 'Ì': 0xC8, 'Í': 0xC9, 'Ñ': 0xCA, 'Ò': 0xCB,
+	'Ó': 0xCC, 'Ù': 0xCD, 'Ú': 0xCE, 'á': 0xCF, 'ì': 0xD0, 'í': 0xD1,
+	'ñ': 0xD2, 'ò': 0xD3, 'ó': 0xD4, 'ú': 0xD5,
+})
+
+// Spanish is the Gen 1 Spanish character set, which is shared with the Italian releases.
+var Spanish = Italian
+
+// with returns a copy of the character set with additional runes.
+func (c Charset) with(additional Charset) Charset {
+	combined := make(Charset, len(c)+len(additional))
+	for r, char := range c {
+		combined[r] = char
+	}
+	for r, char := range additional {
+		combined[r] = char
+	}
+	return combined
+}
+
+// Japanese is the Gen 1 Japanese character set of katakana and hiragana.
+// Some kana are drawn with the same tile in both scripts and so share a byte: へヘ, べベ, ぺペ and りリ.
+var Japanese = Charset{
+	'ア': 0x80, 'イ': 0x81, 'ウ': 0x82, 'エ': 0x83, 'オ': 0x84, 'カ': 0x85,
+	'キ': 0x86, 'ク': 0x87, 'ケ': 0x88, 'コ': 0x89, 'サ': 0x8A, 'シ': 0x8B,
+	'ス': 0x8C, 'セ': 0x8D, 'ソ': 0x8E, 'タ': 0x8F, 'チ': 0x90, 'ツ': 0x91,
+	'テ': 0x92, 'ト': 0x93, 'ナ': 0x94, 'ニ': 0x95, 'ヌ': 0x96, 'ネ': 0x97,
+	'ノ': 0x98, 'ハ': 0x99, 'ヒ': 0x9A, 'フ': 0x9B, 'ホ': 0x9C, 'マ': 0x9D,
+	'ミ': 0x9E, 'ム': 0x9F, 'メ': 0xA0, 'モ': 0xA1, 'ヤ': 0xA2, 'ユ': 0xA3,
+	'ヨ': 0xA4, 'ラ': 0xA5, 'ル': 0xA6, 'レ': 0xA7, 'ロ': 0xA8, 'ワ': 0xA9,
+	'ヲ': 0xAA, 'ン': 0xAB, 'ッ': 0xAC, 'ャ': 0xAD, 'ュ': 0xAE, 'ョ': 0xAF,
+	'ィ': 0xB0, 'ヘ': 0xCD, 'リ': 0xD8,
+	'ァ': 0xE9, 'ゥ': 0xEA, 'ェ': 0xEB, 'ォ': 0xF4,
+
+	'あ': 0xB1, 'い': 0xB2, 'う': 0xB3, 'え': 0xB4, 'お': 0xB5, 'か': 0xB6,
+	'き': 0xB7, 'く': 0xB8, 'け': 0xB9, 'こ': 0xBA, 'さ': 0xBB, 'し': 0xBC,
+	'す': 0xBD, 'せ': 0xBE, 'そ': 0xBF, 'た': 0xC0, 'ち': 0xC1, 'つ': 0xC2,
+	'て': 0xC3, 'と': 0xC4, 'な': 0xC5, 'に': 0xC6, 'ぬ': 0xC7, 'ね': 0xC8,
+	'の': 0xC9, 'は': 0xCA, 'ひ': 0xCB, 'ふ': 0xCC, 'へ': 0xCD, 'ほ': 0xCE,
+	'ま': 0xCF, 'み': 0xD0, 'む': 0xD1, 'め': 0xD2, 'も': 0xD3, 'や': 0xD4,
+	'ゆ': 0xD5, 'よ': 0xD6, 'ら': 0xD7, 'り': 0xD8, 'る': 0xD9, 'れ': 0xDA,
+	'ろ': 0xDB, 'わ': 0xDC, 'を': 0xDD, 'ん': 0xDE, 'っ': 0xDF, 'ゃ': 0xE0,
+	'ゅ': 0xE1, 'ょ': 0xE2,
+
+	'ガ': 0x05, 'ギ': 0x06, 'グ': 0x07, 'ゲ': 0x08, 'ゴ': 0x09, 'ザ': 0x0A,
+	'ジ': 0x0B, 'ズ': 0x0C, 'ゼ': 0x0D, 'ゾ': 0x0E, 'ダ': 0x0F, 'ヂ': 0x10,
+	'ヅ': 0x11, 'デ': 0x12, 'ド': 0x13, 'バ': 0x19, 'ビ': 0x1A, 'ブ': 0x1B,
+	'ボ': 0x1C, 'ベ': 0x3D, 'パ': 0x40, 'ピ': 0x41, 'プ': 0x42, 'ポ': 0x43,
+	'ペ': 0x47,
+
+	'が': 0x26, 'ぎ': 0x27, 'ぐ': 0x28, 'げ': 0x29, 'ご': 0x2A, 'ざ': 0x2B,
+	'じ': 0x2C, 'ず': 0x2D, 'ぜ': 0x2E, 'ぞ': 0x2F, 'だ': 0x30, 'ぢ': 0x31,
+	'づ': 0x32, 'で': 0x33, 'ど': 0x34, 'ば': 0x3A, 'び': 0x3B, 'ぶ': 0x3C,
+	'べ': 0x3D, 'ぼ': 0x3E, 'ぱ': 0x44, 'ぴ': 0x45, 'ぷ': 0x46, 'ぺ': 0x47,
+	'ぽ': 0x48,
+
+	'　': 0x7F, 'ー': 0xE3, '？': 0xE6, '！': 0xE7, '。': 0xE8,
+	'♂': 0xEF, '×': 0xF1, '．': 0xF2, '／': 0xF3, '♀': 0xF5,
+	'０': 0xF6, '１': 0xF7, '２': 0xF8, '３': 0xF9, '４': 0xFA,
+	'５': 0xFB, '６': 0xFC, '７': 0xFD, '８': 0xFE, '９': 0xFF,
+
+	Trainer: 0x5D,
+}
+
+// WriteText writes text to the writer using the character set.
+// Once text is written, a terminator byte 0x50 is written.
+// Additional padding of 0x00 bytes is written to ensure the entire reserved space is utilised.
+// Should the reserved space be insufficient to write the text and terminator, an ErrReservedSpaceInsufficient error is returned.
+func (c Charset) WriteText(w io.Writer, text string, reservedSpace int) error {
+	usedSpace := utf8.RuneCount([]byte(text)) + 1 // +1 for terminator
+	unusedSpace := reservedSpace - usedSpace
+	if unusedSpace < 0 {
+		return fmt.Errorf("cannot fit text %q in %d bytes: %w", text, reservedSpace, ErrReservedSpaceInsufficient)
+	}
+
+	const terminator, padding = 0x50, 0x00
+
+	for _, r := range text {
+		char, present := c[r]
+		if !present {
+			return fmt.Errorf("character %q is not available in the character set", string(r))
+		}
+
+		_, err := w.Write([]byte{char})
+		if err != nil {
+			return fmt.Errorf("failed to write rune %q as %b to writer: %w", r, char, err)
+		}
+	}
+
+	_, err := w.Write([]byte{terminator})
+	if err != nil {
+		return fmt.Errorf("failed to write terminator: %w", err)
+	}
+
+	for i := 0; i < unusedSpace; i++ {
+		_, err := w.Write([]byte{padding})
+		if err != nil {
+			return fmt.Errorf("failed to write padding: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ReadText reads text from b using the character set.
+// Reading stops at the first terminator byte 0x50, or at the end of b should no terminator be present.
+func (c Charset) ReadText(b []byte) (string, error) {
+	const terminator = 0x50
+
+	// Where runes share a byte, the highest rune is read back so the result is stable.
+	// In the Japanese character set this prefers katakana, which names are usually written in.
+	runeConverter := make(map[byte]rune, len(c))
+	for r, char := range c {
+		if existing, present := runeConverter[char]; !present || r > existing {
+			runeConverter[char] = r
+		}
+	}
+
+	text := make([]rune, 0, len(b))
+	for _, char := range b {
+		if char == terminator {
+			break
+		}
+
+		r, present := runeConverter[char]
+		if !present {
+			return "", fmt.Errorf("byte 0x%02X is not available in the character set", char)
+		}
+		text = append(text, r)
+	}
+
+	return string(text), nil
+}
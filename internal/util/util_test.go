@@ -74,3 +74,67 @@ func TestWriteBinaryCodedDecimal_WriteError(t *testing.T) {
 	assert.ErrorIs(t, err, expectedErr)
 }
 
+func TestReadText_StopsAtTerminator(t *testing.T) {
+	text, err := util.ReadText([]byte{0x91, 0x84, 0x83, 0x50, 0x80, 0x00})
+	assert.NoError(t, err)
+	assert.Equal(t, "RED", text)
+}
+
+func TestReadText_NoTerminator(t *testing.T) {
+	text, err := util.ReadText([]byte{0x86, 0xA0, 0xB1, 0xB8})
+	assert.NoError(t, err)
+	assert.Equal(t, "Gary", text)
+}
+
+func TestReadText_UnknownCharacter(t *testing.T) {
+	_, err := util.ReadText([]byte{0x91, 0x00, 0x50})
+	assert.Error(t, err)
+}
+
+func TestReadText_RoundTrip(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := util.WriteText(buf, "FARFETCH'D", 11)
+	assert.NoError(t, err)
+
+	text, err := util.ReadText(buf.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, "FARFETCH'D", text)
+}
+
+func TestJapaneseText_RoundTrip(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := util.Japanese.WriteText(buf, "グリーン", 6)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x07, 0xD8, 0xE3, 0xAB, 0x50, 0x00}, buf.Bytes())
+
+	text, err := util.Japanese.ReadText(buf.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, "グリーン", text)
+}
+
+func TestGermanText_RoundTrip(t *testing.T) {
+	buf := new(bytes.Buffer)
+	err := util.German.WriteText(buf, "Jürgen", 11)
+	assert.NoError(t, err)
+	assert.Equal(t, byte(0xC5), buf.Bytes()[1])
+
+	text, err := util.German.ReadText(buf.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, "Jürgen", text)
+}
+
+func TestEnglishText_NoAccents(t *testing.T) {
+	err := util.English.WriteText(new(bytes.Buffer), "Jürgen", 11)
+	assert.Error(t, err)
+}
+
+func TestReadBinaryCodedDecimal_Valid(t *testing.T) {
+	value, err := util.ReadBinaryCodedDecimal([]byte{0x00, 0x30, 0x00})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3000), value)
+}
+
+func TestReadBinaryCodedDecimal_Invalid(t *testing.T) {
+	_, err := util.ReadBinaryCodedDecimal([]byte{0x00, 0x3A, 0x00})
+	assert.ErrorIs(t, err, util.ErrInvalidBinaryCodedDecimal)
+}
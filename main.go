@@ -1,18 +1,46 @@
 package main
 
 import (
-	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
+	"os"
+	"pokegen/internal/gamedata"
+	"pokegen/internal/gifts"
+	"pokegen/internal/legality"
 	"pokegen/internal/pokegen"
+	"pokegen/internal/progress"
+	"pokegen/internal/random"
+	"pokegen/internal/save"
+	"pokegen/internal/showdown"
+	"reflect"
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		if err := runCommand(os.Args[1:], os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	http.HandleFunc("/gen", genFile)
+	http.HandleFunc("/scenarios", listScenarios)
+	http.HandleFunc("/gifts", listGifts)
+	http.HandleFunc("/openapi.json", serveOpenAPI)
+	http.HandleFunc("/diff", diffFiles)
+	http.HandleFunc("/checksum", validateChecksums)
+	http.HandleFunc("/diagnostics", diagnoseFile)
+	http.HandleFunc("/showdown", exportShowdown)
+	http.HandleFunc("/pk1/export", exportPK1)
+	http.HandleFunc("/pk1/import", importPK1)
+	http.HandleFunc("/trade", tradePokemon)
+	http.HandleFunc("/merge", mergeSaves)
+	http.HandleFunc("/edit", editSave)
 	http.HandleFunc("/health", func(w http.ResponseWriter, req *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		if _, err := w.Write([]byte("OK")); err != nil {
@@ -23,39 +51,208 @@ func main() {
 }
 
 func genFile(w http.ResponseWriter, req *http.Request) {
-	type schema struct {
-		PlayerName string `json:"player_name"`
-		RivalName  string `json:"rival_name"`
-		Money      uint64 `json:"money"`
+	var (
+		opts     pokegen.Options
+		legality string
+		ok       bool
+	)
+	if name := req.URL.Query().Get("scenario"); name != "" {
+		opts, legality, ok = presetOptions(w, req, name)
+	} else if isScenario(req) {
+		opts, legality, ok = scenarioOptions(w, req)
+	} else {
+		opts, legality, ok = requestOptions(w, req)
+	}
+	if !ok {
+		return
+	}
+
+	// The save is generated once, then reconciled and checked before it is written.
+	f, err := pokegen.Generate(opts)
+	if errors.Is(err, pokegen.ErrInvalidOptions) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if opts.Reconcile && !reportReconciliation(w, f) {
+		return
+	}
+	if !checkLegality(w, f, gamedata.Version(opts.Game), legality) {
+		return
+	}
+
+	f.RepairChecksums()
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if _, err = w.Write(f.Bytes()); err != nil {
+		panic(err)
 	}
+}
 
-	// Default values
-	reqBody := schema{
-		PlayerName: "RED",
-		RivalName:  "BLUE",
-		Money:      3000,
+// genRequest is the JSON body of a /gen request, which also describes it in the OpenAPI document.
+type genRequest struct {
+	Game       string `json:"game" enum:"red,blue,yellow"`
+	Language   string `json:"language" enum:"en,fr,de,it,es,ja"`
+	PlayerName string `json:"player_name"`
+	RivalName  string `json:"rival_name"`
+	Money      uint64 `json:"money"`
+	Badges     *int   `json:"badges" minimum:"0" maximum:"8"`
+	Party      *struct {
+		Showdown string              `json:"showdown"`
+		Random   *random.Constraints `json:"random"`
+	} `json:"party"`
+	Gifts             []gifts.Placement `json:"gifts"`
+	LivingDex         bool              `json:"living_dex"`
+	Starter           string            `json:"starter"`
+	PikachuFriendship *uint8            `json:"pikachu_friendship"`
+	Legality          string            `json:"legality" enum:"allow,warn,reject"`
+	Reconcile         bool              `json:"reconcile"`
+}
+
+// defaultGenRequest returns the values of fields a /gen request leaves out; the names default to those of the save's language.
+func defaultGenRequest() genRequest {
+	return genRequest{
+		Game:     string(pokegen.GameRed),
+		Language: string(pokegen.LanguageEnglish),
+		Money:    3000,
+		Legality: legalityAllow,
 	}
+}
+
+// requestOptions reads the options and legality mode from a JSON request body, writing an error response when they are invalid.
+func requestOptions(w http.ResponseWriter, req *http.Request) (pokegen.Options, string, bool) {
+	reqBody := defaultGenRequest()
 
-	err := json.NewDecoder(req.Body).Decode(&reqBody)
+	// Unknown fields are rejected rather than ignored, so typos such as "moneys" are reported.
+	dec := json.NewDecoder(req.Body)
+	dec.DisallowUnknownFields()
+	err := dec.Decode(&reqBody)
 	if err != nil && err != io.EOF {
 		var syntaxErr *json.SyntaxError
 		if errors.As(err, &syntaxErr) {
 			http.Error(w, fmt.Sprintf("syntax error at byte offset %d", syntaxErr.Offset), http.StatusBadRequest)
-			return
+			return pokegen.Options{}, "", false
 		}
 		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
+		return pokegen.Options{}, "", false
+	}
+	if err := validate(reflect.ValueOf(reqBody), ""); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return pokegen.Options{}, "", false
+	}
+
+	var party []pokegen.Pokemon
+	switch {
+	case reqBody.Party == nil:
+	case reqBody.Party.Random != nil && reqBody.Party.Showdown != "":
+		http.Error(w, "party must be either showdown or random, not both", http.StatusBadRequest)
+		return pokegen.Options{}, "", false
+	case reqBody.Party.Random != nil:
+		party, err = random.Team(*reqBody.Party.Random, gamedata.Version(reqBody.Game))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return pokegen.Options{}, "", false
+		}
+	default:
+		party, err = showdown.Parse(reqBody.Party.Showdown)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return pokegen.Options{}, "", false
+		}
+	}
+
+	opts := pokegen.Options{
+		Game:              pokegen.Game(reqBody.Game),
+		Language:          pokegen.Language(reqBody.Language),
+		PlayerName:        reqBody.PlayerName,
+		RivalName:         reqBody.RivalName,
+		Money:             reqBody.Money,
+		Party:             party,
+		LivingDex:         reqBody.LivingDex,
+		Starter:           pokegen.Starter(reqBody.Starter),
+		PikachuFriendship: reqBody.PikachuFriendship,
+		Reconcile:         reqBody.Reconcile,
+	}
+
+	if reqBody.Badges != nil {
+		opts, err = progress.Apply(opts, *reqBody.Badges)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return pokegen.Options{}, "", false
+		}
+	}
+
+	opts, err = gifts.Place(opts, reqBody.Gifts)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return pokegen.Options{}, "", false
+	}
+
+	return opts, reqBody.Legality, true
+}
+
+// Legality modes for generated saves: allow generates them regardless, warn lists each finding in an
+// X-Legality-Finding header, and reject refuses saves with any error findings.
+const (
+	legalityAllow  = "allow"
+	legalityWarn   = "warn"
+	legalityReject = "reject"
+)
+
+// checkLegality applies the legality mode to f, a save of version v, reporting whether generation should continue.
+func checkLegality(w http.ResponseWriter, f *save.File, v gamedata.Version, mode string) bool {
+	switch mode {
+	case legalityAllow:
+		return true
+	case legalityWarn, legalityReject:
+	default:
+		http.Error(w, fmt.Sprintf("legality must be %s, %s or %s, got %q", legalityAllow, legalityWarn, legalityReject, mode), http.StatusBadRequest)
+		return false
 	}
 
-	data := new(bytes.Buffer)
-	_, err = pokegen.Gen(data, reqBody.PlayerName, reqBody.RivalName, reqBody.Money)
+	findings, err := legality.CheckSave(f, v)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
+		return false
 	}
 
-	w.Header().Set("Content-Type", "application/octet-stream")
-	if _, err = w.Write(data.Bytes()); err != nil {
-		panic(err)
+	if mode == legalityReject {
+		var illegal []legality.Finding
+		for _, finding := range findings {
+			if finding.Severity == save.SeverityError {
+				illegal = append(illegal, finding)
+			}
+		}
+		if len(illegal) > 0 {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			if err := json.NewEncoder(w).Encode(illegal); err != nil {
+				panic(err)
+			}
+			return false
+		}
+	}
+
+	for _, finding := range findings {
+		w.Header().Add("X-Legality-Finding", finding.String())
+	}
+	return true
+}
+
+// reportReconciliation reconciles f, listing each fix made in an X-Reconciled header,
+// reporting whether generation should continue.
+func reportReconciliation(w http.ResponseWriter, f *save.File) bool {
+	fixes, err := f.Reconcile()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return false
+	}
+
+	for _, fix := range fixes {
+		w.Header().Add("X-Reconciled", fix.String())
 	}
+	return true
 }
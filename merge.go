@@ -0,0 +1,121 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"pokegen/internal/save"
+	"strings"
+)
+
+// parseTake parses a directive such as box:3=other, which takes the region box:3 from the save called other.
+func parseTake(s string) (save.Region, string, error) {
+	region, from, ok := strings.Cut(s, "=")
+	if !ok || from == "" {
+		return save.Region{}, "", fmt.Errorf("%q must be written as <region>=<save>", s)
+	}
+	r, err := save.ParseRegion(region)
+	if err != nil {
+		return save.Region{}, "", err
+	}
+	return r, from, nil
+}
+
+func merge(args []string, stdout io.Writer) error {
+	const usage = "usage: pokegen merge [--language <code>] <base.sav> <region>=<file.sav>...\n" +
+		"regions are trainer, progress, pokedex, party, boxes, box:<box>, bag, pc_items and daycare"
+
+	flags := flag.NewFlagSet("merge", flag.ContinueOnError)
+	language := flags.String("language", "en", "language of the saves, such as en or ja")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() < 2 {
+		return fmt.Errorf(usage)
+	}
+
+	base, err := loadSaveFile(flags.Arg(0), *language)
+	if err != nil {
+		return err
+	}
+
+	var takes []save.Take
+	for _, arg := range flags.Args()[1:] {
+		region, path, err := parseTake(arg)
+		if err != nil {
+			return err
+		}
+		from, err := loadSaveFile(path, *language)
+		if err != nil {
+			return err
+		}
+		takes = append(takes, save.Take{Region: region, From: from})
+	}
+
+	if _, err := base.Merge(takes); err != nil {
+		return fmt.Errorf("failed to merge: %w", err)
+	}
+	base.RepairChecksums()
+
+	_, err = stdout.Write(base.Bytes())
+	return err
+}
+
+// mergeSaves takes regions into the save uploaded as the "base" multipart form file from other uploaded saves,
+// as directed by each ?take=<region>=<field>, returning it with its checksums recomputed.
+// Each fix made reconciling the merged save is listed in an X-Reconciled header.
+func mergeSaves(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	directives := req.URL.Query()["take"]
+	if len(directives) == 0 {
+		http.Error(w, "at least one take=<region>=<save> is required", http.StatusBadRequest)
+		return
+	}
+
+	base, err := loadUploadedSave(req, "base")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	uploaded := map[string]*save.File{}
+	var takes []save.Take
+	for _, d := range directives {
+		region, field, err := parseTake(d)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		from, ok := uploaded[field]
+		if !ok {
+			from, err = loadUploadedSave(req, field)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			uploaded[field] = from
+		}
+		takes = append(takes, save.Take{Region: region, From: from})
+	}
+
+	fixes, err := base.Merge(takes)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	base.RepairChecksums()
+
+	for _, f := range fixes {
+		w.Header().Add("X-Reconciled", f.String())
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if _, err = w.Write(base.Bytes()); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,412 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"pokegen/internal/gifts"
+	"pokegen/internal/pokegen"
+	"pokegen/internal/save"
+	"pokegen/internal/scenario"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// schema is a JSON Schema object as used by OpenAPI 3.0.
+type schema map[string]interface{}
+
+// schemaBuilder derives schemas from Go types as encoding/json would encode them,
+// collecting named structs as components so they are described once.
+type schemaBuilder struct {
+	components map[string]schema
+}
+
+// of returns the schema of t, with the defaults of any fields set in def, which may be the zero value.
+func (b *schemaBuilder) of(t reflect.Type, def reflect.Value) schema {
+	switch t.Kind() {
+	case reflect.Pointer:
+		var elem reflect.Value
+		if def.IsValid() && !def.IsNil() {
+			elem = def.Elem()
+		}
+		s := b.of(t.Elem(), elem)
+		// A nil pointer encodes as null. OpenAPI 3.0 ignores keywords beside a $ref, so references are wrapped.
+		if _, ok := s["$ref"]; ok {
+			return schema{"allOf": []schema{s}, "nullable": true}
+		}
+		s["nullable"] = true
+		return s
+	case reflect.Struct:
+		if t.Name() == "" {
+			return b.object(t, def)
+		}
+		name := strings.ToUpper(t.Name()[:1]) + t.Name()[1:]
+		if _, ok := b.components[name]; !ok {
+			b.components[name] = nil // Reserved while the fields are described, in case the struct refers to itself.
+			b.components[name] = b.object(t, def)
+		}
+		return schema{"$ref": "#/components/schemas/" + name}
+	case reflect.Slice, reflect.Array:
+		// Byte slices encode as base64 strings.
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return schema{"type": "string", "format": "byte"}
+		}
+		return schema{"type": "array", "items": b.of(t.Elem(), reflect.Value{})}
+	case reflect.Map:
+		return schema{"type": "object", "additionalProperties": b.of(t.Elem(), reflect.Value{})}
+	case reflect.String:
+		return schema{"type": "string"}
+	case reflect.Bool:
+		return schema{"type": "boolean"}
+	case reflect.Float32, reflect.Float64:
+		return schema{"type": "number"}
+	case reflect.Int64:
+		return schema{"type": "integer", "format": "int64"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return schema{"type": "integer"}
+	case reflect.Uint8:
+		return schema{"type": "integer", "minimum": 0, "maximum": math.MaxUint8}
+	case reflect.Uint16:
+		return schema{"type": "integer", "minimum": 0, "maximum": math.MaxUint16}
+	case reflect.Uint, reflect.Uint32, reflect.Uint64:
+		return schema{"type": "integer", "minimum": 0}
+	}
+	return schema{}
+}
+
+// object describes a struct's exported fields by their JSON names. Fields not described are rejected.
+// Values in an enum tag, separated by commas, are listed as the only ones allowed,
+// and minimum and maximum tags bound numbers; validate holds decoded requests to the same tags.
+func (b *schemaBuilder) object(t reflect.Type, def reflect.Value) schema {
+	properties := schema{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, ok := jsonName(field)
+		if !ok {
+			continue
+		}
+
+		var value reflect.Value
+		if def.IsValid() {
+			value = def.Field(i)
+		}
+		s := b.of(field.Type, value)
+		if enum := field.Tag.Get("enum"); enum != "" {
+			s["enum"] = strings.Split(enum, ",")
+		}
+		for _, bound := range []string{"minimum", "maximum"} {
+			if n, ok := tagBound(field, bound); ok {
+				s[bound] = n
+			}
+		}
+		if value.IsValid() && !value.IsZero() && value.Kind() != reflect.Pointer && value.Kind() != reflect.Struct {
+			s["default"] = value.Interface()
+		}
+		properties[name] = s
+	}
+	return schema{"type": "object", "properties": properties, "additionalProperties": false}
+}
+
+// jsonName returns the name encoding/json gives a struct field, reporting whether it is encoded at all.
+func jsonName(field reflect.StructField) (string, bool) {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if !field.IsExported() || name == "-" {
+		return "", false
+	}
+	if name == "" {
+		name = field.Name
+	}
+	return name, true
+}
+
+// tagBound returns the number in the field's minimum or maximum tag, reporting whether it has one.
+func tagBound(field reflect.StructField, bound string) (float64, bool) {
+	tag := field.Tag.Get(bound)
+	if tag == "" {
+		return 0, false
+	}
+	n, err := strconv.ParseFloat(tag, 64)
+	if err != nil {
+		panic(fmt.Sprintf("%s tag of %s: %v", bound, field.Name, err))
+	}
+	return n, true
+}
+
+// validate checks v, decoded from JSON, against the enum, minimum and maximum tags of its fields, so requests are held
+// to the schema the OpenAPI document describes. The bounds derived from numeric types are enforced by decoding already.
+// path names v in errors, such as party.random, and is empty for the request itself.
+func validate(v reflect.Value, path string) error {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return nil
+		}
+		return validate(v.Elem(), path)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			name, ok := jsonName(field)
+			if !ok {
+				continue
+			}
+			if path != "" {
+				name = path + "." + name
+			}
+			if err := validateField(field, v.Field(i), name); err != nil {
+				return err
+			}
+			if err := validate(v.Field(i), name); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := validate(v.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			if err := validate(iter.Value(), fmt.Sprintf("%s[%v]", path, iter.Key())); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateField checks the value of a field against its own tags.
+func validateField(field reflect.StructField, v reflect.Value, name string) error {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	if enum := field.Tag.Get("enum"); enum != "" {
+		allowed := strings.Split(enum, ",")
+		value := fmt.Sprint(v.Interface())
+		found := false
+		for _, a := range allowed {
+			found = found || a == value
+		}
+		if !found {
+			return fmt.Errorf("%s must be one of %s, got %q", name, strings.Join(allowed, ", "), value)
+		}
+	}
+
+	var n float64
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n = float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n = float64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		n = v.Float()
+	default:
+		return nil
+	}
+	if min, ok := tagBound(field, "minimum"); ok && n < min {
+		return fmt.Errorf("%s must be at least %v, got %v", name, min, n)
+	}
+	if max, ok := tagBound(field, "maximum"); ok && n > max {
+		return fmt.Errorf("%s must be at most %v, got %v", name, max, n)
+	}
+	return nil
+}
+
+// openAPI returns the OpenAPI document describing every endpoint, derived from the types they decode and encode.
+// Endpoints taking saves read them from multipart form files, in the layout of the language form field.
+func openAPI() schema {
+	b := &schemaBuilder{components: map[string]schema{}}
+	request := b.of(reflect.TypeOf(genRequest{}), reflect.ValueOf(defaultGenRequest()))
+	presets := b.of(reflect.TypeOf([]scenario.Preset{}), reflect.Value{})
+	library := b.of(reflect.TypeOf([]gifts.Gift{}), reflect.Value{})
+	differences := b.of(reflect.TypeOf([]save.Difference{}), reflect.Value{})
+	checksums := b.of(reflect.TypeOf(checksumReport{}), reflect.Value{})
+	problems := b.of(reflect.TypeOf([]save.Problem{}), reflect.Value{})
+	operations := b.of(reflect.TypeOf([]save.Operation{}), reflect.Value{})
+	traded := b.of(reflect.TypeOf(tradeResult{}), reflect.Value{})
+
+	binary := schema{"type": "string", "format": "binary"}
+	text := func(description string) schema {
+		return schema{"description": description, "content": schema{"text/plain": schema{"schema": schema{"type": "string"}}}}
+	}
+	jsonBody := func(description string, s schema) schema {
+		return schema{"description": description, "content": schema{"application/json": schema{"schema": s}}}
+	}
+	saveFile := schema{"description": "The save file", "content": schema{"application/octet-stream": schema{"schema": binary}}}
+
+	query := func(name, description string) schema {
+		return schema{"name": name, "in": "query", "description": description, "schema": schema{"type": "string"}}
+	}
+	required := func(name, description string) schema {
+		q := query(name, description)
+		q["required"] = true
+		return q
+	}
+	flag := func(name, description string) schema {
+		return schema{"name": name, "in": "query", "description": description, "schema": schema{"type": "boolean"}}
+	}
+
+	language, _ := reflect.TypeOf(genRequest{}).FieldByName("Language")
+	// form describes a multipart form of the named files, the language saves among them are read in and any fields in extra.
+	form := func(files []string, extra schema) schema {
+		properties := schema{"language": schema{
+			"type":        "string",
+			"enum":        strings.Split(language.Tag.Get("enum"), ","),
+			"default":     string(pokegen.LanguageEnglish),
+			"description": "Language of the release the saves are from, which may be given as a query parameter instead",
+		}}
+		for _, f := range files {
+			properties[f] = binary
+		}
+		for name, s := range extra {
+			properties[name] = s
+		}
+		return schema{"type": "object", "properties": properties, "required": files}
+	}
+	upload := func(media schema) schema {
+		return schema{"required": true, "content": schema{"multipart/form-data": media}}
+	}
+	uploadSaves := func(files ...string) schema {
+		return upload(schema{"schema": form(files, nil)})
+	}
+
+	// The operations are a JSON list in a form field of their own.
+	editForm := schema{
+		"schema":   form([]string{"save", "operations"}, schema{"operations": operations}),
+		"encoding": schema{"operations": schema{"contentType": "application/json"}},
+	}
+	// The saves regions are taken from are uploaded in the fields the take parameters name.
+	mergeForm := form([]string{"base"}, nil)
+	mergeForm["additionalProperties"] = binary
+
+	// post describes an endpoint taking uploaded saves, which answers 400 when they cannot be read
+	// and 422 when they cannot be worked on.
+	post := func(summary string, parameters []schema, body, ok schema) schema {
+		op := schema{
+			"summary":     summary,
+			"requestBody": body,
+			"responses": schema{
+				"200": ok,
+				"400": text("The request is invalid or a save cannot be read"),
+				"422": text("The saves cannot be worked on as asked"),
+			},
+		}
+		if len(parameters) > 0 {
+			op["parameters"] = parameters
+		}
+		return schema{"post": op}
+	}
+	list := func(summary string, items schema) schema {
+		return schema{"get": schema{
+			"summary": summary,
+			"responses": schema{
+				"200": jsonBody(summary, items),
+			},
+		}}
+	}
+	slot := "A one based slot such as party:1 or box:3:12"
+
+	return schema{
+		"openapi": "3.0.3",
+		"info": schema{
+			"title":   "Pokégen",
+			"version": "1.0.0",
+		},
+		"paths": schema{
+			"/gen": schema{"post": schema{
+				"summary": "Generate a save file",
+				"parameters": []schema{
+					query("scenario", "Name of a built-in scenario to generate the save from"),
+					query("legality", "Legality mode for scenario bodies"),
+				},
+				"requestBody": schema{"content": schema{
+					"application/json": schema{"schema": request},
+					"application/yaml": schema{"schema": schema{"type": "string", "description": "A scenario file"}},
+				}},
+				"responses": schema{
+					"200": saveFile,
+					"400": text("The request is invalid"),
+					"422": schema{"description": "The save holds Pokémon the legality mode rejects"},
+				},
+			}},
+			"/scenarios": list("The built-in scenarios", presets),
+			"/gifts":     list("The in-game trade and event Pokémon", library),
+			"/openapi.json": schema{"get": schema{
+				"summary":   "This document",
+				"responses": schema{"200": jsonBody("This document", schema{"type": "object"})},
+			}},
+			"/health": schema{"get": schema{
+				"summary":   "Check the server is up",
+				"responses": schema{"200": text("OK")},
+			}},
+			"/diff": post("Compare two saves field by field", nil,
+				uploadSaves("before", "after"),
+				jsonBody("The differences", differences)),
+			"/checksum": post("Verify or repair a save's checksums",
+				[]schema{flag("repair", "Return the save with its checksums recomputed instead of the report")},
+				uploadSaves("save"),
+				schema{"description": "The report, or the repaired save", "content": schema{
+					"application/json":         schema{"schema": checksums},
+					"application/octet-stream": schema{"schema": binary},
+				}}),
+			"/diagnostics": post("Report structural problems in a save", nil,
+				uploadSaves("save"),
+				jsonBody("The problems found", problems)),
+			"/showdown": post("Export a save's party as Showdown team text",
+				[]schema{flag("boxes", "Export each box holding Pokémon too")},
+				uploadSaves("save"),
+				text("The Showdown team text")),
+			"/pk1/export": post("Export a Pokémon as a .pk1 file",
+				[]schema{required("slot", slot)},
+				uploadSaves("save"),
+				schema{"description": "The .pk1 file", "content": schema{"application/octet-stream": schema{"schema": binary}}}),
+			"/pk1/import": post("Place a .pk1 file's Pokémon in a save",
+				[]schema{required("slot", slot)},
+				uploadSaves("save", "pk1"),
+				saveFile),
+			"/trade": post("Link trade a Pokémon between two saves",
+				[]schema{
+					required("a", slot+" in save a"),
+					required("b", slot+" in save b"),
+					flag("evolve", "Evolve Pokémon that evolve by trading, which they do unless false"),
+				},
+				uploadSaves("a", "b"),
+				jsonBody("Both saves after the trade and the Pokémon each received", traded)),
+			"/edit": post("Apply a list of operations to a save", nil,
+				upload(editForm),
+				saveFile),
+			"/merge": post("Take regions of other saves into a base save",
+				[]schema{{
+					"name":        "take",
+					"in":          "query",
+					"required":    true,
+					"description": "A region to take and the form field of the save to take it from, such as party=other",
+					"schema":      schema{"type": "array", "items": schema{"type": "string"}},
+					"explode":     true,
+				}},
+				upload(schema{"schema": mergeForm}),
+				saveFile),
+		},
+		"components": schema{"schemas": b.components},
+	}
+}
+
+// serveOpenAPI serves the OpenAPI document, from which clients can be generated.
+func serveOpenAPI(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(openAPI()); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"pokegen/internal/pokegen"
+	"pokegen/internal/scenario"
+)
+
+// maxScenarioSize is far larger than any scenario describing a single save.
+const maxScenarioSize = 1 << 20
+
+func gen(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("gen", flag.ContinueOnError)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		return fmt.Errorf("usage: pokegen gen <scenario.yaml>")
+	}
+
+	opts, err := scenario.LoadFile(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	if err := pokegen.Gen(stdout, opts); err != nil {
+		return fmt.Errorf("failed to generate save: %w", err)
+	}
+	return nil
+}
+
+// isScenario reports whether the request body is a scenario rather than the JSON request schema.
+// JSON is YAML, so JSON scenarios are sent with a YAML content type too.
+func isScenario(req *http.Request) bool {
+	mediaType, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/yaml", "application/x-yaml", "text/yaml":
+		return true
+	}
+	return false
+}
+
+// scenarioOptions reads the options from a scenario request body, writing an error response when it is invalid.
+// The scenario may extend the built-in scenarios.
+func scenarioOptions(w http.ResponseWriter, req *http.Request) (pokegen.Options, string, bool) {
+	data, ok := readScenario(w, req)
+	if !ok {
+		return pokegen.Options{}, "", false
+	}
+
+	opts, err := scenario.Parse(data, "scenario", scenario.Library)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return pokegen.Options{}, "", false
+	}
+	return opts, queryLegality(req), true
+}
+
+// presetOptions reads the options of the built-in scenario named name, with the scenario in the request body,
+// if any, applied on top of it, writing an error response when either is invalid.
+func presetOptions(w http.ResponseWriter, req *http.Request, name string) (pokegen.Options, string, bool) {
+	data, ok := readScenario(w, req)
+	if !ok {
+		return pokegen.Options{}, "", false
+	}
+
+	opts, err := scenario.LoadPreset(name, data)
+	if errors.Is(err, scenario.ErrUnknownPreset) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return pokegen.Options{}, "", false
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return pokegen.Options{}, "", false
+	}
+	return opts, queryLegality(req), true
+}
+
+// readScenario reads a scenario from the request body, writing an error response when it cannot.
+func readScenario(w http.ResponseWriter, req *http.Request) ([]byte, bool) {
+	data, err := io.ReadAll(io.LimitReader(req.Body, maxScenarioSize+1))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to read scenario: %v", err), http.StatusBadRequest)
+		return nil, false
+	}
+	if len(data) > maxScenarioSize {
+		http.Error(w, fmt.Sprintf("scenario is larger than %d bytes", maxScenarioSize), http.StatusRequestEntityTooLarge)
+		return nil, false
+	}
+	return data, true
+}
+
+// queryLegality returns the legality mode given by the legality query parameter, as it is not part of scenarios.
+func queryLegality(req *http.Request) string {
+	if legality := req.URL.Query().Get("legality"); legality != "" {
+		return legality
+	}
+	return legalityAllow
+}
+
+// listScenarios lists the built-in scenarios as JSON.
+func listScenarios(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	presets, err := scenario.Presets()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(presets); err != nil {
+		panic(err)
+	}
+}
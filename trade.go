@@ -0,0 +1,80 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"pokegen/internal/save"
+	"strconv"
+)
+
+// tradedSave is one side of a trade: the save after the trade, encoded as base64 in JSON, and the Pokémon it received.
+type tradedSave struct {
+	Received save.Traded `json:"received"`
+	Save     []byte      `json:"save"`
+}
+
+// tradeResult is the response of /trade: both sides of the trade.
+type tradeResult struct {
+	A tradedSave `json:"a"`
+	B tradedSave `json:"b"`
+}
+
+// tradePokemon link trades the Pokémon in ?a= of the save uploaded as the "a" multipart form file
+// for the one in ?b= of the save uploaded as "b", returning both saves with their checksums recomputed.
+// Pokémon that evolve by trading do so unless ?evolve=false.
+func tradePokemon(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := req.URL.Query()
+	slotA, err := save.ParseSlot(query.Get("a"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	slotB, err := save.ParseSlot(query.Get("b"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	evolve := true
+	if v := query.Get("evolve"); v != "" {
+		evolve, err = strconv.ParseBool(v)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("evolve must be true or false, got %q", v), http.StatusBadRequest)
+			return
+		}
+	}
+
+	a, err := loadUploadedSave(req, "a")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	b, err := loadUploadedSave(req, "b")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	toA, toB, err := save.Trade(a, slotA, b, slotB, evolve)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+	a.RepairChecksums()
+	b.RepairChecksums()
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(tradeResult{
+		A: tradedSave{Received: toA, Save: a.Bytes()},
+		B: tradedSave{Received: toB, Save: b.Bytes()},
+	})
+	if err != nil {
+		panic(err)
+	}
+}